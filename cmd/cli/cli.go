@@ -5,10 +5,12 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sync"
 	"syscall"
 
 	"github.com/alecthomas/kingpin"
+	"github.com/chains-lab/voting-svc/docs"
 	"github.com/chains-lab/voting-svc/internal/app"
 	"github.com/chains-lab/voting-svc/internal/config"
 	"github.com/chains-lab/voting-svc/internal/dbx"
@@ -32,10 +34,11 @@ func Run(args []string) bool {
 		migrateUpCmd   = migrateCmd.Command("up", "migrate db up")
 		migrateDownCmd = migrateCmd.Command("down", "migrate db down")
 
-		//docs = service.Command("docs", "documentation command")
-		//
-		//generateDocs = docs.Command("generate", "generate API documentation")
-		//openDocs     = docs.Command("open", "open API documentation in browser")
+		docsCmd = service.Command("docs", "documentation command")
+
+		generateDocsCmd = docsCmd.Command("generate", "generate API documentation")
+		docsOutput      = generateDocsCmd.Flag("output", "where to write the OpenAPI document").Default(filepath.Join("docs", docs.SpecFile)).String()
+		//openDocsCmd = docsCmd.Command("open", "open API documentation in browser")
 	)
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -62,6 +65,8 @@ func Run(args []string) bool {
 		err = dbx.MigrateUp(cfg)
 	case migrateDownCmd.FullCommand():
 		err = dbx.MigrateDown(cfg)
+	case generateDocsCmd.FullCommand():
+		err = GenerateDocs(cfg, *docsOutput)
 	default:
 		logger.Errorf("unknown command %s", cmd)
 		return false
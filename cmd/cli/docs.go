@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/chains-lab/voting-svc/docs"
+	"github.com/chains-lab/voting-svc/internal/config"
+)
+
+// GenerateDocs writes the OpenAPI document served by the HTTP gateway to output.
+func GenerateDocs(cfg config.Config, output string) error {
+	spec, err := docs.Spec(cfg.Server.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+		return fmt.Errorf("creating directory for %s: %w", output, err)
+	}
+	if err := os.WriteFile(output, spec, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", output, err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/chains-lab/voting-svc/internal/api"
 	"github.com/chains-lab/voting-svc/internal/app"
 	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/chains-lab/voting-svc/internal/workers"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 )
@@ -14,6 +15,9 @@ func Start(ctx context.Context, cfg config.Config, log *logrus.Logger, app *app.
 	eg, ctx := errgroup.WithContext(ctx)
 
 	eg.Go(func() error { return api.Run(ctx, cfg, log, app) })
+	eg.Go(func() error { return api.RunGateway(ctx, cfg, log) })
+	eg.Go(func() error { return workers.RunExpiry(ctx, cfg, log, app) })
+	eg.Go(func() error { return workers.RunLive(ctx, cfg, log, app) })
 
 	return eg.Wait()
 }
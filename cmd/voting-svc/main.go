@@ -1,6 +1,10 @@
-package voting_svc
+package main
 
-import "os"
+import (
+	"os"
+
+	"github.com/chains-lab/voting-svc/cmd/cli"
+)
 
 func main() {
 	if !cli.Run(os.Args) {
@@ -0,0 +1,32 @@
+// Package docs embeds the OpenAPI document generated from proto/voting/v1 by protoc-gen-openapiv2.
+package docs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// SpecFile — имя файла, который кладёт buf generate (merge_file_name в buf.gen.yaml).
+const SpecFile = "voting.swagger.json"
+
+//go:embed voting.swagger.json
+var spec []byte
+
+// Spec returns the OpenAPI document titled with the service name.
+// protoc-gen-openapiv2 takes the title from the first merged proto file, so it is replaced here.
+func Spec(title string) ([]byte, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("parsing embedded %s: %w", SpecFile, err)
+	}
+
+	info, _ := doc["info"].(map[string]any)
+	if info == nil {
+		info = map[string]any{}
+	}
+	info["title"] = title
+	doc["info"] = info
+
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,139 @@
+// Package ape defines the domain errors of the service and how they are reported over gRPC.
+package ape
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain — домен ErrorInfo, по паре (domain, reason) клиенты различают ошибки.
+const Domain = "voting-svc"
+
+// Стабильные значения ErrorInfo.reason.
+const (
+	ReasonNotFound          = "NOT_FOUND"
+	ReasonInvalidInput      = "INVALID_INPUT"
+	ReasonInvalidVote       = "INVALID_VOTE"
+	ReasonUnauthenticated   = "UNAUTHENTICATED"
+	ReasonForbidden         = "FORBIDDEN"
+	ReasonInvalidTransition = "INVALID_TRANSITION"
+	ReasonClosedForVoting   = "CLOSED_FOR_VOTING"
+	ReasonOptionsLocked     = "OPTIONS_LOCKED"
+	ReasonAlreadySigned     = "ALREADY_SIGNED"
+	ReasonAlreadyVoted      = "ALREADY_VOTED"
+	ReasonAlreadyExists     = "ALREADY_EXISTS"
+	ReasonReferenceNotFound = "REFERENCE_NOT_FOUND"
+	ReasonRateLimited       = "RATE_LIMITED"
+	ReasonUnavailable       = "UNAVAILABLE"
+	ReasonInternal          = "INTERNAL"
+)
+
+var (
+	ErrNotFound          = newError(ReasonNotFound, codes.NotFound, "not found")
+	ErrInvalidInput      = newError(ReasonInvalidInput, codes.InvalidArgument, "invalid input")
+	ErrInvalidVote       = newError(ReasonInvalidVote, codes.InvalidArgument, "invalid vote")
+	ErrUnauthenticated   = newError(ReasonUnauthenticated, codes.Unauthenticated, "authentication required")
+	ErrForbidden         = newError(ReasonForbidden, codes.PermissionDenied, "action is not permitted for the actor")
+	ErrInvalidTransition = newError(ReasonInvalidTransition, codes.FailedPrecondition, "invalid status transition")
+	ErrClosedForVoting   = newError(ReasonClosedForVoting, codes.FailedPrecondition, "voting is closed")
+	ErrOptionsLocked     = newError(ReasonOptionsLocked, codes.FailedPrecondition, "poll options are locked once voting has started")
+	ErrAlreadySigned     = newError(ReasonAlreadySigned, codes.AlreadyExists, "already signed")
+	ErrAlreadyVoted      = newError(ReasonAlreadyVoted, codes.AlreadyExists, "already voted")
+	ErrAlreadyExists     = newError(ReasonAlreadyExists, codes.AlreadyExists, "already exists")
+	ErrReferenceNotFound = newError(ReasonReferenceNotFound, codes.InvalidArgument, "referenced object does not exist")
+	ErrRateLimited       = newError(ReasonRateLimited, codes.ResourceExhausted, "too many requests")
+	ErrUnavailable       = newError(ReasonUnavailable, codes.Unavailable, "temporarily unavailable, retry later")
+	ErrInternal          = newError(ReasonInternal, codes.Internal, "internal error")
+)
+
+// FieldViolation names the request field an error is about.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error with a stable reason and the gRPC code it is reported with.
+// Errors with the same reason match each other in errors.Is, so wrapped instances still compare
+// equal to the exported sentinels.
+type Error struct {
+	Reason     string
+	Code       codes.Code
+	Message    string
+	Violations []FieldViolation
+
+	cause error // исходная ошибка: попадает в лог, но не клиенту
+}
+
+func newError(reason string, code codes.Code, message string) *Error {
+	return &Error{Reason: reason, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	if len(e.Violations) == 0 {
+		return e.Message
+	}
+
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return e.Message + ": " + strings.Join(parts, "; ")
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Reason == e.Reason
+}
+
+// GRPCStatus lets grpc-go report the error with ErrorInfo and, for field errors, BadRequest details.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code, e.Error())
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: e.Reason, Domain: Domain}}
+	if len(e.Violations) > 0 {
+		br := &errdetails.BadRequest{}
+		for _, v := range e.Violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, br)
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// Wrap returns a copy of the domain error that keeps cause for logging.
+func Wrap(e *Error, cause error) *Error {
+	c := *e
+	c.cause = cause
+	return &c
+}
+
+// WithMessage returns a copy of the domain error with a more specific message; the reason and code stay.
+func WithMessage(e *Error, format string, args ...any) *Error {
+	c := *e
+	c.Message = fmt.Sprintf(format, args...)
+	return &c
+}
+
+// InvalidField is ErrInvalidInput narrowed to one request field.
+func InvalidField(field, format string, args ...any) *Error {
+	c := *ErrInvalidInput
+	c.Violations = []FieldViolation{{Field: field, Description: fmt.Sprintf(format, args...)}}
+	return &c
+}
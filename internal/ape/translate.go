@@ -0,0 +1,69 @@
+package ape
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/status"
+)
+
+// Коды ошибок Postgres, которые означают ошибку клиента, а не сервера.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgCheckViolation      = "23514"
+	pgInvalidText         = "22P02"
+)
+
+// pgKeyDetail вытаскивает колонки из DETAIL вида "Key (poll_id, user_id)=(...) already exists."
+var pgKeyDetail = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+
+// Translate turns an error returned by the application into one grpc-go can report.
+// Domain errors and gRPC statuses are returned as they are; sql.ErrNoRows and client-side Postgres errors
+// are mapped to domain errors, and everything else becomes ErrInternal. The original error is kept for logging.
+func Translate(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return Wrap(ErrNotFound, err)
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return Wrap(ErrInternal, err)
+	}
+
+	var e *Error
+	switch pqErr.Code {
+	case pgUniqueViolation:
+		e = Wrap(ErrAlreadyExists, err)
+	case pgForeignKeyViolation:
+		e = Wrap(ErrReferenceNotFound, err)
+	case pgCheckViolation, pgInvalidText:
+		e = Wrap(ErrInvalidInput, err)
+	default:
+		return Wrap(ErrInternal, err)
+	}
+
+	if field := pgField(pqErr); field != "" {
+		e.Violations = []FieldViolation{{Field: field, Description: e.Message}}
+	}
+	return e
+}
+
+func pgField(err *pq.Error) string {
+	if err.Column != "" {
+		return err.Column
+	}
+	if m := pgKeyDetail.FindStringSubmatch(err.Detail); m != nil {
+		return m[1]
+	}
+	return ""
+}
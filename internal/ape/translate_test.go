@@ -0,0 +1,135 @@
+package ape
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		want       *Error
+		violations []FieldViolation
+	}{
+		{
+			name: "no rows",
+			err:  fmt.Errorf("poll: %w", sql.ErrNoRows),
+			want: ErrNotFound,
+		},
+		{
+			name: "unique violation",
+			err: &pq.Error{
+				Code:   pgUniqueViolation,
+				Detail: "Key (poll_id, user_id)=(5c8a1e2f-7b3d-4f6a-9e0c-1d2b3a4c5e6f, 7f1c2a4e-1d55-4b6e-9a36-54c1d1c6b2a0) already exists.",
+			},
+			want:       ErrAlreadyExists,
+			violations: []FieldViolation{{Field: "poll_id, user_id", Description: ErrAlreadyExists.Message}},
+		},
+		{
+			name: "foreign key violation",
+			err: &pq.Error{
+				Code:   pgForeignKeyViolation,
+				Detail: `Key (option_id)=(5c8a1e2f-7b3d-4f6a-9e0c-1d2b3a4c5e6f) is not present in table "poll_options".`,
+			},
+			want:       ErrReferenceNotFound,
+			violations: []FieldViolation{{Field: "option_id", Description: ErrReferenceNotFound.Message}},
+		},
+		{
+			name: "check violation",
+			err:  &pq.Error{Code: pgCheckViolation, Constraint: "polls_max_choices_check"},
+			want: ErrInvalidInput,
+		},
+		{
+			name: "invalid text representation",
+			err:  &pq.Error{Code: pgInvalidText, Message: `invalid input syntax for type uuid: "abc"`},
+			want: ErrInvalidInput,
+		},
+		{
+			// колонка из ответа сервера точнее, чем разбор DETAIL
+			name:       "column takes precedence over detail",
+			err:        &pq.Error{Code: pgUniqueViolation, Column: "option_text", Detail: "Key (poll_id, lower(option_text::text))=(…) already exists."},
+			want:       ErrAlreadyExists,
+			violations: []FieldViolation{{Field: "option_text", Description: ErrAlreadyExists.Message}},
+		},
+		{
+			name: "detail without key",
+			err:  &pq.Error{Code: pgUniqueViolation, Detail: "Failing row contains (…)."},
+			want: ErrAlreadyExists,
+		},
+		{
+			name: "wrapped postgres error",
+			err:  fmt.Errorf("inserting vote: %w", &pq.Error{Code: pgForeignKeyViolation}),
+			want: ErrReferenceNotFound,
+		},
+		{
+			name: "other postgres error",
+			err:  &pq.Error{Code: "53300", Message: "too many connections"},
+			want: ErrInternal,
+		},
+		{
+			name: "plain error",
+			err:  errors.New("connection reset"),
+			want: ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Translate(tt.err)
+
+			var e *Error
+			if !errors.As(got, &e) {
+				t.Fatalf("Translate = %T %v, want a domain error", got, got)
+			}
+			if e.Reason != tt.want.Reason || e.Code != tt.want.Code {
+				t.Fatalf("reason = %s (%s), want %s (%s)", e.Reason, e.Code, tt.want.Reason, tt.want.Code)
+			}
+			if !reflect.DeepEqual(e.Violations, tt.violations) {
+				t.Fatalf("violations = %+v, want %+v", e.Violations, tt.violations)
+			}
+			// исходная ошибка остаётся для лога
+			if !errors.Is(got, tt.err) {
+				t.Fatalf("cause %v is lost", tt.err)
+			}
+		})
+	}
+}
+
+// Доменные ошибки и статусы gRPC уже готовы к ответу и проходят без изменений.
+func TestTranslateKeepsReadyErrors(t *testing.T) {
+	domain := fmt.Errorf("petition 1: %w", ErrAlreadySigned)
+	grpcStatus := status.Error(codes.Unavailable, "shutting down")
+
+	for _, err := range []error{nil, domain, grpcStatus, InvalidField("title", "is required")} {
+		if got := Translate(err); got != err {
+			t.Fatalf("Translate(%v) = %v, want it unchanged", err, got)
+		}
+	}
+}
+
+func TestPgField(t *testing.T) {
+	tests := map[string]string{
+		"Key (id)=(1) already exists.":                   "id",
+		"Key (poll_id, user_id)=(1, 2) already exists.":  "poll_id, user_id",
+		`Key (city_id)=(1) is not present in table "x".`: "city_id",
+		"Failing row contains (1, 2).":                   "",
+		"":                                               "",
+		"Detail: Key (id)=(1) already exists.":           "",
+		// ключ по выражению (индекс poll_options_text_uniq) не выдаётся за поле запроса
+		"Key (poll_id, lower(option_text::text))=(1, да) already exists.": "",
+	}
+
+	for detail, want := range tests {
+		if got := pgField(&pq.Error{Detail: detail}); got != want {
+			t.Errorf("pgField(%q) = %q, want %q", detail, got, want)
+		}
+	}
+}
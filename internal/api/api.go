@@ -4,20 +4,67 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/chains-lab/voting-svc/internal/api/policy"
+	"github.com/chains-lab/voting-svc/internal/api/ratelimit"
+	"github.com/chains-lab/voting-svc/internal/api/service"
+	"github.com/chains-lab/voting-svc/internal/app"
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/chains-lab/voting-svc/internal/logger"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
+// shutdownTimeout — сколько ждать завершения активных вызовов; live-стримы сами не заканчиваются.
+const shutdownTimeout = 10 * time.Second
+
 func Run(ctx context.Context, cfg config.Config, log *logrus.Logger, app *app.App) error {
 	// 1) Создаём реализацию хэндлеров и interceptor
 	server := service.NewService(cfg, app)
-	authInterceptor := interceptors.NewAuth(cfg.JWT.Service.SecretKey, cfg.JWT.User.AccessToken.SecretKey)
+	lifetime := cfg.JWT.User.AccessToken.TokenLifetime
+	authInterceptor := interceptors.NewAuth(cfg.JWT.Service.SecretKey, cfg.JWT.User.AccessToken.SecretKey, lifetime)
+	streamAuthInterceptor := interceptors.NewStreamAuth(cfg.JWT.Service.SecretKey, cfg.JWT.User.AccessToken.SecretKey, lifetime)
 
 	// 2) Инициализируем gRPC‐сервер
+	limiter, err := ratelimit.NewLimiter(cfg)
+	if err != nil {
+		return err
+	}
+	rateLimitInterceptor := ratelimit.NewInterceptor(cfg.RateLimit, limiter, log)
+
+	// логирование снаружи всех: оно выдаёт request ID и ловит паники;
+	// rate limit и policy идут после auth: им нужен пользователь из контекста
+	requestLog := logger.NewWithBase(log)
+	accessPolicy := policy.New(app, log)
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authInterceptor),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryLogInterceptor(requestLog),
+			authInterceptor,
+			rateLimitInterceptor.Unary(),
+			accessPolicy.UnaryInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			logger.StreamLogInterceptor(requestLog),
+			streamAuthInterceptor,
+			rateLimitInterceptor.Stream(),
+			accessPolicy.StreamInterceptor(),
+		),
 	)
 	svc.RegisterUserServiceServer(grpcServer, server)
 	svc.RegisterAdminServiceServer(grpcServer, server)
 
+	// health стартует в NOT_SERVING и переключается по готовности БД
+	healthServer := newHealthServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	if cfg.Server.Reflection {
+		reflection.Register(grpcServer)
+	}
+
 	// 3) Открываем слушатель
 	lis, err := net.Listen("tcp", cfg.Server.Port)
 	if err != nil {
@@ -31,11 +78,26 @@ func Run(ctx context.Context, cfg config.Config, log *logrus.Logger, app *app.Ap
 		serveErrCh <- grpcServer.Serve(lis)
 	}()
 
+	readinessCtx, stopReadiness := context.WithCancel(ctx)
+	defer stopReadiness()
+	go watchReadiness(readinessCtx, healthServer, log, app)
+
 	// 5) Слушаем контекст и окончание Serve()
 	select {
 	case <-ctx.Done():
 		log.Info("shutting down gRPC server …")
-		grpcServer.GracefulStop()
+		// Shutdown переводит все сервисы в NOT_SERVING, пока идёт GracefulStop
+		healthServer.Shutdown()
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			grpcServer.Stop()
+		}
 		return nil
 	case err := <-serveErrCh:
 		return fmt.Errorf("gRPC Serve() exited: %w", err)
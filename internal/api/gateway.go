@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/chains-lab/voting-svc/docs"
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	defaultSwaggerURL = "/swagger"
+
+	// defaultSwaggerUIVersion — swagger-ui-dist, если swagger.ui.version не задан; хеши SRI задаются под неё.
+	defaultSwaggerUIVersion = "5.17.14"
+)
+
+var (
+	exactVersion = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	sriHash      = regexp.MustCompile(`^sha(256|384|512)-[A-Za-z0-9+/]+={0,2}$`)
+)
+
+// RunGateway serves the HTTP/JSON face of the gRPC services on swagger.port,
+// together with the OpenAPI document and Swagger UI when swagger.enabled is set.
+func RunGateway(ctx context.Context, cfg config.Config, log *logrus.Logger) error {
+	if cfg.Swagger.Port == "" {
+		log.Info("HTTP gateway is disabled: swagger.port is not set")
+		return nil
+	}
+
+	// gateway ходит в наш же gRPC-сервер, запросы транскодируются по правилам из proto/voting/v1/gateway.yaml
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+	)
+	endpoint := grpcEndpoint(cfg.Server.Port)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	if err := svc.RegisterUserServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register UserService gateway: %w", err)
+	}
+	if err := svc.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return fmt.Errorf("failed to register AdminService gateway: %w", err)
+	}
+
+	root := http.NewServeMux()
+	root.Handle("/", mux)
+	if cfg.Swagger.Enabled {
+		if err := registerSwagger(root, cfg, log); err != nil {
+			return err
+		}
+	}
+
+	srv := &http.Server{
+		Addr:    cfg.Swagger.Port,
+		Handler: root,
+	}
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		log.Infof("HTTP gateway listening on %s", cfg.Swagger.Port)
+		serveErrCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		log.Info("shutting down HTTP gateway …")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			// live-стримы через gateway тоже сами не заканчиваются
+			return srv.Close()
+		}
+		return nil
+	case err := <-serveErrCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("HTTP gateway exited: %w", err)
+	}
+}
+
+func registerSwagger(mux *http.ServeMux, cfg config.Config, log *logrus.Logger) error {
+	spec, err := docs.Spec(cfg.Server.Name)
+	if err != nil {
+		return err
+	}
+
+	base := "/" + strings.Trim(cfg.Swagger.URL, "/")
+	if base == "/" {
+		base = defaultSwaggerURL
+	}
+	specURL := base + "/" + docs.SpecFile
+
+	mux.HandleFunc(specURL, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	})
+
+	ui := cfg.Swagger.UI
+	if ui.Version == "" {
+		ui.Version = defaultSwaggerUIVersion
+	}
+	if !exactVersion.MatchString(ui.Version) {
+		return fmt.Errorf("swagger.ui.version %q is not an exact version", ui.Version)
+	}
+	if ui.CSSIntegrity == "" || ui.JSIntegrity == "" {
+		log.Warnf("Swagger UI is disabled: swagger.ui integrity hashes are not set, serving only %s", specURL)
+		return nil
+	}
+	if !sriHash.MatchString(ui.CSSIntegrity) || !sriHash.MatchString(ui.JSIntegrity) {
+		return errors.New("swagger.ui integrity hashes must look like sha384-<base64>")
+	}
+
+	page := []byte(fmt.Sprintf(swaggerUIPage, cfg.Server.Name,
+		ui.Version, ui.CSSIntegrity, ui.Version, ui.JSIntegrity, specURL))
+	serveUI := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(page)
+	}
+	mux.HandleFunc(base, serveUI)
+	mux.HandleFunc(base+"/", serveUI)
+	return nil
+}
+
+// incomingHeader пробрасывает X-Request-Id клиента в метаданные вызова.
+func incomingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, interceptors.RequestIDHeader) {
+		return interceptors.RequestIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader отдаёт X-Request-Id как обычный HTTP-заголовок, остальное — с префиксом Grpc-Metadata-, как по умолчанию.
+func outgoingHeader(key string) (string, bool) {
+	if key == interceptors.RequestIDHeader {
+		return http.CanonicalHeaderKey(key), true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// grpcEndpoint превращает ":8002" из server.port в адрес, по которому можно подключиться.
+func grpcEndpoint(port string) string {
+	if strings.HasPrefix(port, ":") {
+		return "localhost" + port
+	}
+	return port
+}
+
+// swaggerUIPage — статика Swagger UI берётся с CDN, чтобы не держать её в репозитории. Версия закреплена
+// точно, а integrity не даёт браузеру выполнить подменённый на CDN файл.
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>%s API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@%s/swagger-ui.css" integrity="%s" crossorigin="anonymous">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@%s/swagger-ui-bundle.js" integrity="%s" crossorigin="anonymous"></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: %q, dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/app"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	readinessInterval = 5 * time.Second
+	readinessTimeout  = 3 * time.Second
+)
+
+// healthServices — "" означает состояние сервера целиком.
+var healthServices = []string{
+	"",
+	svc.UserService_ServiceDesc.ServiceName,
+	svc.AdminService_ServiceDesc.ServiceName,
+}
+
+func newHealthServer() *health.Server {
+	hs := health.NewServer()
+	for _, name := range healthServices {
+		hs.SetServingStatus(name, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	}
+	return hs
+}
+
+// watchReadiness periodically checks the application and reports SERVING only while it is ready.
+func watchReadiness(ctx context.Context, hs *health.Server, log *logrus.Logger, app *app.App) {
+	ticker := time.NewTicker(readinessInterval)
+	defer ticker.Stop()
+
+	// логируем только смену состояния, чтобы не шуметь каждые readinessInterval
+	ready, checked := false, false
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, readinessTimeout)
+		err := app.Ready(checkCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		// после Shutdown() health-сервер игнорирует смену статусов, гонки с остановкой нет
+		switch {
+		case err == nil && !ready:
+			log.Info("service is ready")
+			setHealthStatus(hs, grpc_health_v1.HealthCheckResponse_SERVING)
+		case err != nil && (ready || !checked):
+			log.WithError(err).Warn("service is not ready")
+			setHealthStatus(hs, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+		}
+		ready, checked = err == nil, true
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func setHealthStatus(hs *health.Server, status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	for _, name := range healthServices {
+		hs.SetServingStatus(name, status)
+	}
+}
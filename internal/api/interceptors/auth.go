@@ -0,0 +1,211 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Ключи метаданных с токенами.
+const (
+	AuthorizationHeader = "authorization"   // "Bearer <user access token>"
+	ServiceTokenHeader  = "x-service-token" // токен вызывающего сервиса
+)
+
+// UserClaims — полезная нагрузка пользовательского access-токена, sub содержит ID пользователя.
+type UserClaims struct {
+	jwt.RegisteredClaims
+	Roles  []string `json:"roles"`
+	CityID string   `json:"city_id,omitempty"`
+}
+
+// ServiceClaims — полезная нагрузка сервисного токена, sub содержит имя сервиса.
+type ServiceClaims struct {
+	jwt.RegisteredClaims
+}
+
+// publicMethods — служебные сервисы, которые вызываются без токенов (оркестратор, grpcurl).
+var publicMethods = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// IsPublicMethod reports whether the method is served without tokens.
+func IsPublicMethod(method string) bool {
+	for _, prefix := range publicMethods {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type authenticator struct {
+	serviceSecret []byte
+	userSecret    []byte
+	userLifetime  time.Duration
+}
+
+// NewAuth returns a unary interceptor that authenticates calls by user access or service tokens.
+func NewAuth(serviceSecret, userSecret string, userLifetime time.Duration) grpc.UnaryServerInterceptor {
+	a := authenticator{
+		serviceSecret: []byte(serviceSecret),
+		userSecret:    []byte(userSecret),
+		userLifetime:  userLifetime,
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewStreamAuth is the streaming counterpart of NewAuth.
+func NewStreamAuth(serviceSecret, userSecret string, userLifetime time.Duration) grpc.StreamServerInterceptor {
+	a := authenticator{
+		serviceSecret: []byte(serviceSecret),
+		userSecret:    []byte(userSecret),
+		userLifetime:  userLifetime,
+	}
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate проверяет токены из метаданных и кладёт результат в контекст.
+// Достаточно одного валидного токена, но присланный невалидный токен отклоняет вызов целиком.
+func (a authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if IsPublicMethod(method) {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	serviceToken := firstValue(md, ServiceTokenHeader)
+	userToken, err := bearerToken(firstValue(md, AuthorizationHeader))
+	if err != nil {
+		return nil, err
+	}
+	if serviceToken == "" && userToken == "" {
+		return nil, ape.WithMessage(ape.ErrUnauthenticated, "missing access token")
+	}
+
+	if serviceToken != "" {
+		service, err := a.parseService(serviceToken)
+		if err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, ServiceCtxKey, service)
+	}
+
+	if userToken != "" {
+		user, err := a.parseUser(userToken)
+		if err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, UserCtxKey, user)
+		if call, ok := ctx.Value(CallCtxKey).(*CallInfo); ok {
+			call.UserID = user.ID
+		}
+	}
+
+	return ctx, nil
+}
+
+func (a authenticator) parseUser(raw string) (UserData, error) {
+	var claims UserClaims
+	if _, err := jwt.ParseWithClaims(raw, &claims, keyFunc(a.userSecret), parserOptions...); err != nil {
+		return UserData{}, ape.WithMessage(ape.ErrUnauthenticated, "invalid access token: %v", err)
+	}
+
+	// exp выставляет выпустивший сервис, а TokenLifetime — наша верхняя граница жизни токена
+	if a.userLifetime > 0 {
+		if claims.IssuedAt == nil {
+			return UserData{}, ape.WithMessage(ape.ErrUnauthenticated, "invalid access token: iat is required")
+		}
+		if time.Since(claims.IssuedAt.Time) > a.userLifetime {
+			return UserData{}, ape.WithMessage(ape.ErrUnauthenticated, "access token is expired")
+		}
+	}
+
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return UserData{}, ape.WithMessage(ape.ErrUnauthenticated, "invalid access token: subject is not a user id")
+	}
+
+	user := UserData{
+		ID:    id,
+		Roles: claims.Roles,
+	}
+	if claims.CityID != "" {
+		cityID, err := uuid.Parse(claims.CityID)
+		if err != nil {
+			return UserData{}, ape.WithMessage(ape.ErrUnauthenticated, "invalid access token: malformed city_id")
+		}
+		user.CityID = &cityID
+	}
+	return user, nil
+}
+
+func (a authenticator) parseService(raw string) (ServiceData, error) {
+	var claims ServiceClaims
+	if _, err := jwt.ParseWithClaims(raw, &claims, keyFunc(a.serviceSecret), parserOptions...); err != nil {
+		return ServiceData{}, ape.WithMessage(ape.ErrUnauthenticated, "invalid service token: %v", err)
+	}
+	if claims.Subject == "" {
+		return ServiceData{}, ape.WithMessage(ape.ErrUnauthenticated, "invalid service token: subject is required")
+	}
+	return ServiceData{Name: claims.Subject}, nil
+}
+
+var parserOptions = []jwt.ParserOption{
+	jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+	jwt.WithExpirationRequired(),
+}
+
+func keyFunc(secret []byte) jwt.Keyfunc {
+	return func(*jwt.Token) (any, error) {
+		return secret, nil
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func bearerToken(header string) (string, error) {
+	if header == "" {
+		return "", nil
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", ape.WithMessage(ape.ErrUnauthenticated, "authorization header must be 'Bearer <token>'")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// contextStream подменяет контекст стрима на аутентифицированный.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
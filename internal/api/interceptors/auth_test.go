@@ -0,0 +1,318 @@
+package interceptors
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testServiceSecret = "service-secret"
+	testUserSecret    = "user-secret"
+	testLifetime      = 15 * time.Minute
+	testMethod        = "/voting.v1.UserService/VotePoll"
+)
+
+var (
+	testUserID = uuid.MustParse("7f1c2a4e-1d55-4b6e-9a36-54c1d1c6b2a0")
+	testCityID = uuid.MustParse("2b0e4c9d-8f6a-4e3b-9c1d-5a7f6e8d9c0b")
+)
+
+func sign(t *testing.T, method jwt.SigningMethod, claims jwt.Claims, secret string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return token
+}
+
+// userClaims — валидные claims пользователя; тест меняет в копии то, что проверяет.
+func userClaims() UserClaims {
+	now := time.Now()
+	return UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   testUserID.String(),
+			IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Roles:  []string{"moderator"},
+		CityID: testCityID.String(),
+	}
+}
+
+func serviceClaims() ServiceClaims {
+	return ServiceClaims{RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   "petitions-svc",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+}
+
+func userToken(t *testing.T, edit func(c *UserClaims)) string {
+	c := userClaims()
+	if edit != nil {
+		edit(&c)
+	}
+	return sign(t, jwt.SigningMethodHS256, c, testUserSecret)
+}
+
+func TestAuth(t *testing.T) {
+	validUser := userToken(t, nil)
+	validService := sign(t, jwt.SigningMethodHS256, serviceClaims(), testServiceSecret)
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, userClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := strings.Split(userToken(t, func(c *UserClaims) { c.Roles = []string{"city_admin"} }), ".")
+	parts := strings.Split(validUser, ".")
+	tampered := parts[0] + "." + forged[1] + "." + parts[2]
+	noServiceSubject := serviceClaims()
+	noServiceSubject.Subject = ""
+
+	city := testCityID
+	wantUser := &UserData{ID: testUserID, Roles: []string{"moderator"}, CityID: &city}
+	wantService := &ServiceData{Name: "petitions-svc"}
+
+	tests := []struct {
+		name    string
+		method  string
+		md      metadata.MD
+		code    codes.Code
+		user    *UserData
+		service *ServiceData
+	}{
+		{
+			name: "user token",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+validUser),
+			user: wantUser,
+		},
+		{
+			name: "scheme is case-insensitive",
+			md:   metadata.Pairs(AuthorizationHeader, "bearer "+validUser),
+			user: wantUser,
+		},
+		{
+			name: "user without city",
+			md: metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, func(c *UserClaims) {
+				c.CityID = ""
+				c.Roles = nil
+			})),
+			user: &UserData{ID: testUserID},
+		},
+		{
+			name:    "service token",
+			md:      metadata.Pairs(ServiceTokenHeader, validService),
+			service: wantService,
+		},
+		{
+			name:    "service and user tokens",
+			md:      metadata.Pairs(ServiceTokenHeader, validService, AuthorizationHeader, "Bearer "+validUser),
+			user:    wantUser,
+			service: wantService,
+		},
+		{
+			name:   "public method without tokens",
+			method: "/grpc.health.v1.Health/Check",
+		},
+
+		// ---------- метаданные
+		{name: "no metadata", code: codes.Unauthenticated},
+		{name: "empty authorization", md: metadata.Pairs(AuthorizationHeader, ""), code: codes.Unauthenticated},
+		{name: "basic scheme", md: metadata.Pairs(AuthorizationHeader, "Basic "+validUser), code: codes.Unauthenticated},
+		{name: "token without scheme", md: metadata.Pairs(AuthorizationHeader, validUser), code: codes.Unauthenticated},
+		{name: "bearer without token", md: metadata.Pairs(AuthorizationHeader, "Bearer  "), code: codes.Unauthenticated},
+		{name: "garbage token", md: metadata.Pairs(AuthorizationHeader, "Bearer not.a.jwt"), code: codes.Unauthenticated},
+
+		// ---------- подпись и алгоритм
+		{
+			// подпись от настоящего токена, а claims подменены на роль city_admin
+			name: "tampered claims",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+tampered),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "alg none",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+unsigned),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "HS512 with the right secret",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+sign(t, jwt.SigningMethodHS512, userClaims(), testUserSecret)),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "user token signed with the service secret",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+sign(t, jwt.SigningMethodHS256, userClaims(), testServiceSecret)),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "user token as service token",
+			md:   metadata.Pairs(ServiceTokenHeader, validUser),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "service token as user token",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+validService),
+			code: codes.Unauthenticated,
+		},
+
+		// ---------- claims
+		{
+			name: "no exp",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, func(c *UserClaims) { c.ExpiresAt = nil })),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "expired",
+			md: metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, func(c *UserClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Second))
+			})),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "no iat",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, func(c *UserClaims) { c.IssuedAt = nil })),
+			code: codes.Unauthenticated,
+		},
+		{
+			// exp ещё не наступил, но токен старше нашей границы TokenLifetime
+			name: "older than token lifetime",
+			md: metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, func(c *UserClaims) {
+				c.IssuedAt = jwt.NewNumericDate(time.Now().Add(-testLifetime - time.Minute))
+			})),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "subject is not a user id",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, func(c *UserClaims) { c.Subject = "admin" })),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "malformed city",
+			md:   metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, func(c *UserClaims) { c.CityID = "kyiv" })),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "service token without subject",
+			md:   metadata.Pairs(ServiceTokenHeader, sign(t, jwt.SigningMethodHS256, noServiceSubject, testServiceSecret)),
+			code: codes.Unauthenticated,
+		},
+		{
+			// невалидный токен отклоняет вызов, даже если второй токен в порядке
+			name: "valid service token with invalid user token",
+			md:   metadata.Pairs(ServiceTokenHeader, validService, AuthorizationHeader, "Bearer not.a.jwt"),
+			code: codes.Unauthenticated,
+		},
+	}
+
+	interceptor := NewAuth(testServiceSecret, testUserSecret, testLifetime)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = testMethod
+			}
+			call := &CallInfo{RequestID: "req-1"}
+			ctx := context.WithValue(context.Background(), CallCtxKey, call)
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var handled context.Context
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+				handled = ctx
+				return nil, nil
+			})
+
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s (%v), want %s", code, err, tt.code)
+			}
+			if tt.code != codes.OK {
+				if handled != nil {
+					t.Fatal("handler ran for a rejected call")
+				}
+				if call.UserID != uuid.Nil {
+					t.Fatalf("call info names user %s of a rejected call", call.UserID)
+				}
+				return
+			}
+
+			user, ok := handled.Value(UserCtxKey).(UserData)
+			switch {
+			case tt.user == nil && ok:
+				t.Fatalf("unexpected user %+v", user)
+			case tt.user != nil && !reflect.DeepEqual(user, *tt.user):
+				t.Fatalf("user = %+v, want %+v", user, *tt.user)
+			}
+			if tt.user != nil && call.UserID != tt.user.ID {
+				t.Fatalf("call info user = %s, want %s", call.UserID, tt.user.ID)
+			}
+
+			service, ok := handled.Value(ServiceCtxKey).(ServiceData)
+			switch {
+			case tt.service == nil && ok:
+				t.Fatalf("unexpected service %+v", service)
+			case tt.service != nil && service != *tt.service:
+				t.Fatalf("service = %+v, want %+v", service, *tt.service)
+			}
+		})
+	}
+}
+
+// Без TokenLifetime iat не обязателен: срок жизни определяет только exp.
+func TestAuthWithoutLifetime(t *testing.T) {
+	interceptor := NewAuth(testServiceSecret, testUserSecret, 0)
+	token := userToken(t, func(c *UserClaims) { c.IssuedAt = nil })
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeader, "Bearer "+token))
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, func(context.Context, any) (any, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("token without iat rejected: %v", err)
+	}
+}
+
+func TestStreamAuth(t *testing.T) {
+	interceptor := NewStreamAuth(testServiceSecret, testUserSecret, testLifetime)
+	info := &grpc.StreamServerInfo{FullMethod: "/voting.v1.UserService/WatchPollTally", IsServerStream: true}
+
+	t.Run("authenticated", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeader, "Bearer "+userToken(t, nil)))
+		err := interceptor(nil, &testStream{ctx: ctx}, info, func(_ any, ss grpc.ServerStream) error {
+			if user, ok := ss.Context().Value(UserCtxKey).(UserData); !ok || user.ID != testUserID {
+				t.Fatalf("stream context has no user: %+v", user)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		err := interceptor(nil, &testStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error {
+			t.Fatal("handler ran without a token")
+			return nil
+		})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("code = %s, want Unauthenticated", status.Code(err))
+		}
+	})
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context { return s.ctx }
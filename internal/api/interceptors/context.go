@@ -0,0 +1,38 @@
+package interceptors
+
+import "github.com/google/uuid"
+
+type ctxKey int
+
+const (
+	// UserCtxKey holds the UserData of the authenticated caller.
+	UserCtxKey ctxKey = iota
+	// ServiceCtxKey holds the ServiceData when the call came with a service token.
+	ServiceCtxKey
+	// LogCtxKey holds the request-scoped logger.Logger.
+	LogCtxKey
+	// CallCtxKey holds the *CallInfo of the current call.
+	CallCtxKey
+)
+
+// RequestIDHeader is the metadata key the request ID is read from and returned in.
+const RequestIDHeader = "x-request-id"
+
+// UserData is the caller as identified by the access token.
+type UserData struct {
+	ID     uuid.UUID
+	Roles  []string
+	CityID *uuid.UUID // city the moderator/city_gov roles are scoped to
+}
+
+// ServiceData is the calling service as identified by the service token.
+type ServiceData struct {
+	Name string
+}
+
+// CallInfo is shared by the interceptors of one call: the logging interceptor creates it,
+// the auth interceptor fills in the caller, so the final log line can name the user.
+type CallInfo struct {
+	RequestID string
+	UserID    uuid.UUID // uuid.Nil until the caller is authenticated
+}
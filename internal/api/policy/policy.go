@@ -0,0 +1,270 @@
+// Package policy authorizes RPC calls by the caller's roles and relation to the affected petition, poll or proposal.
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EntityCity — цель правила, заданная city_id запроса, а не строкой в БД.
+const EntityCity = "city"
+
+// Relation is a bit set of the ways the caller may relate to the target.
+type Relation uint8
+
+const (
+	Initiator     Relation = 1 << iota // создатель петиции, опроса или предложения
+	Addressee                          // адресат петиции или предложения (address_to_id или власти города)
+	CityModerator                      // модератор города, к которому относится цель
+)
+
+// Target names the request field that identifies the object the rule is checked against.
+type Target struct {
+	Entity string // models.Entity* или EntityCity
+	Field  string // имя поля в proto-запросе
+}
+
+// Rule describes who may call a method. AnyCaller lets in every authenticated call, service tokens included;
+// otherwise a user token is required, and Roles and Relations both have to be satisfied,
+// each by at least one of the listed values; empty ones are not checked.
+type Rule struct {
+	AnyCaller bool
+	Roles     []string
+	Target    Target
+	Relations Relation
+}
+
+// owner — поля строки, по которым определяются отношения актора к цели.
+type owner struct {
+	cityID      uuid.UUID
+	initiatorID uuid.UUID
+	addressToID *uuid.UUID
+	addressable bool // у опросов адресата нет
+}
+
+// Owners loads the petitions, polls and proposals rules are checked against; app.App implements it.
+type Owners interface {
+	GetPetition(ctx context.Context, id uuid.UUID) (models.Petition, error)
+	GetPoll(ctx context.Context, id uuid.UUID) (models.Poll, error)
+	GetProposal(ctx context.Context, id uuid.UUID) (models.Proposal, error)
+}
+
+type Policy struct {
+	app   Owners
+	log   *logrus.Logger
+	rules map[string]Rule
+}
+
+func New(app Owners, log *logrus.Logger) Policy {
+	return Policy{
+		app:   app,
+		log:   log,
+		rules: rules,
+	}
+}
+
+// UnaryInterceptor enforces the policy; it has to run after the auth interceptor.
+func (p Policy) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := p.Authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is the streaming counterpart of UnaryInterceptor. The request of a stream arrives
+// as its first message, so the rule is checked when the handler receives it.
+func (p Policy) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &authorizedStream{ServerStream: ss, policy: p, method: info.FullMethod})
+	}
+}
+
+// authorizedStream проверяет правило метода на первом сообщении клиента.
+type authorizedStream struct {
+	grpc.ServerStream
+	policy     Policy
+	method     string
+	authorized bool
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.authorized {
+		if err := s.policy.Authorize(s.Context(), s.method, m); err != nil {
+			return err
+		}
+		s.authorized = true
+	}
+	return nil
+}
+
+// Authorize checks the rule of the method against the caller from the context.
+// A method without a rule is denied, so a new RPC stays closed until it gets one.
+func (p Policy) Authorize(ctx context.Context, method string, req any) error {
+	if interceptors.IsPublicMethod(method) {
+		return nil
+	}
+	rule, ok := p.rules[method]
+	if !ok {
+		return p.deny(method, nil, "method has no access rule")
+	}
+	if rule.AnyCaller {
+		return nil
+	}
+
+	user, ok := ctx.Value(interceptors.UserCtxKey).(interceptors.UserData)
+	if !ok {
+		return p.deny(method, nil, "method requires a user token")
+	}
+	actor := entities.Actor{
+		UserID: user.ID,
+		Roles:  user.Roles,
+		CityID: user.CityID,
+	}
+
+	if len(rule.Roles) > 0 && !hasAnyRole(actor, rule.Roles) {
+		return p.deny(method, &actor, fmt.Sprintf("one of roles %v is required", rule.Roles))
+	}
+
+	if rule.Relations == 0 {
+		return nil
+	}
+
+	id, err := targetID(req, rule.Target)
+	if err != nil {
+		return err
+	}
+	o, err := p.owner(ctx, rule.Target.Entity, id)
+	if err != nil {
+		return err
+	}
+
+	if related(actor, o)&rule.Relations == 0 {
+		return p.deny(method, &actor, fmt.Sprintf("caller is not %s of %s %s", rule.Relations, rule.Target.Entity, id))
+	}
+	return nil
+}
+
+func (p Policy) deny(method string, actor *entities.Actor, reason string) error {
+	entry := p.log.WithFields(logrus.Fields{
+		"method": method,
+		"reason": reason,
+	})
+	if actor != nil {
+		entry = entry.WithField("user_id", actor.UserID)
+	}
+	entry.Warn("access denied")
+
+	return ape.WithMessage(ape.ErrForbidden, "%s", reason)
+}
+
+// owner загружает строку цели; отсутствующая строка — NotFound, как ответил бы сам метод.
+func (p Policy) owner(ctx context.Context, entity string, id uuid.UUID) (owner, error) {
+	var (
+		o   owner
+		err error
+	)
+	switch entity {
+	case EntityCity:
+		o = owner{cityID: id}
+	case models.EntityPetition:
+		var petition models.Petition
+		petition, err = p.app.GetPetition(ctx, id)
+		o = owner{cityID: petition.CityID, initiatorID: petition.InitiatorID, addressToID: petition.AddressToID, addressable: true}
+	case models.EntityPoll:
+		var poll models.Poll
+		poll, err = p.app.GetPoll(ctx, id)
+		o = owner{cityID: poll.CityID, initiatorID: poll.InitiatorID}
+	case models.EntityProposal:
+		var proposal models.Proposal
+		proposal, err = p.app.GetProposal(ctx, id)
+		o = owner{cityID: proposal.CityID, initiatorID: proposal.InitiatorID, addressToID: proposal.AddressToID, addressable: true}
+	default:
+		return owner{}, ape.Wrap(ape.ErrInternal, fmt.Errorf("policy: unknown entity %q", entity))
+	}
+
+	switch {
+	case errors.Is(err, entities.ErrNotFound):
+		return owner{}, ape.WithMessage(ape.ErrNotFound, "%s %s not found", entity, id)
+	case err != nil:
+		return owner{}, ape.Translate(err)
+	}
+	return o, nil
+}
+
+func related(actor entities.Actor, o owner) Relation {
+	var rel Relation
+	if o.initiatorID != uuid.Nil && actor.UserID == o.initiatorID {
+		rel |= Initiator
+	}
+	if o.addressable && actor.AddresseeOf(o.cityID, o.addressToID) {
+		rel |= Addressee
+	}
+	if actor.ModeratorOf(o.cityID) {
+		rel |= CityModerator
+	}
+	return rel
+}
+
+func (r Relation) String() string {
+	var names []string
+	if r&Initiator != 0 {
+		names = append(names, "initiator")
+	}
+	if r&Addressee != 0 {
+		names = append(names, "addressee")
+	}
+	if r&CityModerator != 0 {
+		names = append(names, "city moderator")
+	}
+	switch len(names) {
+	case 0:
+		return "related"
+	case 1:
+		return names[0]
+	}
+	return fmt.Sprintf("one of %v", names)
+}
+
+// targetID читает ID цели из поля запроса по имени из правила.
+func targetID(req any, t Target) (uuid.UUID, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return uuid.Nil, ape.Wrap(ape.ErrInternal, errors.New("policy: request is not a proto message"))
+	}
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(t.Field))
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return uuid.Nil, ape.Wrap(ape.ErrInternal, fmt.Errorf("policy: request has no string field %s", t.Field))
+	}
+
+	value := msg.ProtoReflect().Get(fd).String()
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, ape.InvalidField(t.Field, "%q is not a valid uuid", value)
+	}
+	return id, nil
+}
+
+func hasAnyRole(actor entities.Actor, roles []string) bool {
+	for _, role := range roles {
+		if actor.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,319 @@
+package policy
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var (
+	cityID      = uuid.MustParse("2b0e4c9d-8f6a-4e3b-9c1d-5a7f6e8d9c0b")
+	otherCityID = uuid.MustParse("9d1e6f3a-2c4b-4a8e-8f7d-3b5c6a9e1d20")
+	targetUUID  = uuid.MustParse("5c8a1e2f-7b3d-4f6a-9e0c-1d2b3a4c5e6f")
+	initiatorID = uuid.MustParse("7f1c2a4e-1d55-4b6e-9a36-54c1d1c6b2a0")
+	addresseeID = uuid.MustParse("0a9b8c7d-6e5f-4a3b-8c1d-2e3f4a5b6c7d")
+)
+
+// fakeOwners отдаёт одну и ту же цель в городе cityID и запоминает, какую сущность загружали.
+type fakeOwners struct {
+	loaded []string
+}
+
+func (f *fakeOwners) GetPetition(context.Context, uuid.UUID) (models.Petition, error) {
+	f.loaded = append(f.loaded, models.EntityPetition)
+	addressTo := addresseeID
+	return models.Petition{CityID: cityID, InitiatorID: initiatorID, AddressToID: &addressTo}, nil
+}
+
+func (f *fakeOwners) GetPoll(context.Context, uuid.UUID) (models.Poll, error) {
+	f.loaded = append(f.loaded, models.EntityPoll)
+	return models.Poll{CityID: cityID, InitiatorID: initiatorID}, nil
+}
+
+func (f *fakeOwners) GetProposal(context.Context, uuid.UUID) (models.Proposal, error) {
+	f.loaded = append(f.loaded, models.EntityProposal)
+	addressTo := addresseeID
+	return models.Proposal{CityID: cityID, InitiatorID: initiatorID, AddressToID: &addressTo}, nil
+}
+
+func newTestPolicy(owners Owners) Policy {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	return New(owners, log)
+}
+
+// Вызывающие, для которых проверяется каждый метод; nil — вызов только с сервисным токеном.
+var callers = map[string]*interceptors.UserData{
+	"anonymous":          nil,
+	"user":               {ID: uuid.MustParse("3e4f5a6b-7c8d-4e9f-8a1b-2c3d4e5f6a7b")},
+	"initiator":          {ID: initiatorID},
+	"addressee":          {ID: addresseeID},
+	"city moderator":     {ID: uuid.MustParse("6b7c8d9e-0f1a-4b2c-9d3e-4f5a6b7c8d9e"), Roles: []string{entities.RoleModerator}, CityID: &cityID},
+	"foreign moderator":  {ID: uuid.MustParse("8d9e0f1a-2b3c-4d4e-8f5a-6b7c8d9e0f1a"), Roles: []string{entities.RoleModerator}, CityID: &otherCityID},
+	"city gov of target": {ID: uuid.MustParse("1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"), Roles: []string{entities.RoleCityGov}, CityID: &cityID},
+}
+
+// access — ожидаемый доступ группы методов: кому разрешено и какую сущность правило загружает.
+type access struct {
+	allowed []string
+	entity  string // пусто — правило не смотрит в БД
+}
+
+var (
+	everyone      = access{allowed: []string{"anonymous", "user", "initiator", "addressee", "city moderator", "foreign moderator", "city gov of target"}}
+	everyUser     = access{allowed: []string{"user", "initiator", "addressee", "city moderator", "foreign moderator", "city gov of target"}}
+	anyModerator  = access{allowed: []string{"city moderator", "foreign moderator"}}
+	cityModerator = access{allowed: []string{"city moderator"}}
+)
+
+func initiatorOf(entity string) access {
+	return access{allowed: []string{"initiator"}, entity: entity}
+}
+
+func addresseeOf(entity string) access {
+	return access{allowed: []string{"addressee"}, entity: entity}
+}
+
+func moderatorOfEntity(entity string) access {
+	return access{allowed: []string{"city moderator"}, entity: entity}
+}
+
+// expected перечисляет каждый RPC явно: правка rules.go, меняющая доступ, должна менять и этот список.
+var expected = map[string]access{
+	svc.UserService_GetPetition_FullMethodName:         everyone,
+	svc.UserService_ListPetitions_FullMethodName:       everyone,
+	svc.UserService_GetPoll_FullMethodName:             everyone,
+	svc.UserService_ListPolls_FullMethodName:           everyone,
+	svc.UserService_GetPollResults_FullMethodName:      everyone,
+	svc.UserService_WatchPollTally_FullMethodName:      everyone,
+	svc.UserService_GetProposal_FullMethodName:         everyone,
+	svc.UserService_ListProposals_FullMethodName:       everyone,
+	svc.UserService_WatchProposalTally_FullMethodName:  everyone,
+	svc.UserService_CreatePetition_FullMethodName:      everyUser,
+	svc.UserService_SignPetition_FullMethodName:        everyUser,
+	svc.UserService_UnsignPetition_FullMethodName:      everyUser,
+	svc.UserService_GetMySignature_FullMethodName:      everyUser,
+	svc.UserService_CreatePoll_FullMethodName:          everyUser,
+	svc.UserService_VotePoll_FullMethodName:            everyUser,
+	svc.UserService_RetractPollVote_FullMethodName:     everyUser,
+	svc.UserService_GetMyPollVote_FullMethodName:       everyUser,
+	svc.UserService_CreateProposal_FullMethodName:      everyUser,
+	svc.UserService_VoteProposal_FullMethodName:        everyUser,
+	svc.UserService_RetractProposalVote_FullMethodName: everyUser,
+	svc.UserService_GetMyProposalVote_FullMethodName:   everyUser,
+
+	svc.UserService_WithdrawMyPetition_FullMethodName: initiatorOf(models.EntityPetition),
+	svc.UserService_AnswerPetition_FullMethodName:     addresseeOf(models.EntityPetition),
+	svc.UserService_WithdrawMyPoll_FullMethodName:     initiatorOf(models.EntityPoll),
+	svc.UserService_AddPollOption_FullMethodName:      initiatorOf(models.EntityPoll),
+	svc.UserService_EditPollOption_FullMethodName:     initiatorOf(models.EntityPoll),
+	svc.UserService_RemovePollOption_FullMethodName:   initiatorOf(models.EntityPoll),
+	svc.UserService_ReorderPollOptions_FullMethodName: initiatorOf(models.EntityPoll),
+	svc.UserService_WithdrawMyProposal_FullMethodName: initiatorOf(models.EntityProposal),
+	svc.UserService_AnswerProposal_FullMethodName:     addresseeOf(models.EntityProposal),
+
+	svc.AdminService_ListPendingPetitions_FullMethodName:    cityModerator,
+	svc.AdminService_PublishPetition_FullMethodName:         moderatorOfEntity(models.EntityPetition),
+	svc.AdminService_DeclinePetition_FullMethodName:         moderatorOfEntity(models.EntityPetition),
+	svc.AdminService_WithdrawPetition_FullMethodName:        moderatorOfEntity(models.EntityPetition),
+	svc.AdminService_EditPetition_FullMethodName:            moderatorOfEntity(models.EntityPetition),
+	svc.AdminService_DeletePetitionSignature_FullMethodName: moderatorOfEntity(models.EntityPetition),
+	svc.AdminService_ListPendingPolls_FullMethodName:        cityModerator,
+	svc.AdminService_PublishPoll_FullMethodName:             moderatorOfEntity(models.EntityPoll),
+	svc.AdminService_DeclinePoll_FullMethodName:             moderatorOfEntity(models.EntityPoll),
+	svc.AdminService_WithdrawPoll_FullMethodName:            moderatorOfEntity(models.EntityPoll),
+	svc.AdminService_EditPoll_FullMethodName:                moderatorOfEntity(models.EntityPoll),
+	svc.AdminService_DeletePollVote_FullMethodName:          moderatorOfEntity(models.EntityPoll),
+	svc.AdminService_ListPendingProposals_FullMethodName:    cityModerator,
+	svc.AdminService_PublishProposal_FullMethodName:         moderatorOfEntity(models.EntityProposal),
+	svc.AdminService_DeclineProposal_FullMethodName:         moderatorOfEntity(models.EntityProposal),
+	svc.AdminService_WithdrawProposal_FullMethodName:        moderatorOfEntity(models.EntityProposal),
+	svc.AdminService_EditProposal_FullMethodName:            moderatorOfEntity(models.EntityProposal),
+	svc.AdminService_DeleteProposalVote_FullMethodName:      moderatorOfEntity(models.EntityProposal),
+	svc.AdminService_ListModerationHistory_FullMethodName:   anyModerator,
+}
+
+// registeredMethods — все RPC, которые сервер регистрирует, с дескриптором их запроса.
+func registeredMethods(t *testing.T) map[string]protoreflect.MethodDescriptor {
+	t.Helper()
+	methods := map[string]protoreflect.MethodDescriptor{}
+	for _, sd := range []*grpc.ServiceDesc{&svc.UserService_ServiceDesc, &svc.AdminService_ServiceDesc} {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(sd.ServiceName))
+		if err != nil {
+			t.Fatalf("service %s: %v", sd.ServiceName, err)
+		}
+		service := d.(protoreflect.ServiceDescriptor)
+
+		names := make([]string, 0, len(sd.Methods)+len(sd.Streams))
+		for _, m := range sd.Methods {
+			names = append(names, m.MethodName)
+		}
+		for _, s := range sd.Streams {
+			names = append(names, s.StreamName)
+		}
+		for _, name := range names {
+			md := service.Methods().ByName(protoreflect.Name(name))
+			if md == nil {
+				t.Fatalf("method %s.%s has no descriptor", sd.ServiceName, name)
+			}
+			methods["/"+sd.ServiceName+"/"+name] = md
+		}
+	}
+	return methods
+}
+
+// request собирает запрос метода с ID цели во всех полях, по которым правила её ищут.
+func request(t *testing.T, md protoreflect.MethodDescriptor) proto.Message {
+	t.Helper()
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		t.Fatalf("request type of %s: %v", md.FullName(), err)
+	}
+	msg := mt.New()
+	fields := msg.Descriptor().Fields()
+	for name, value := range map[protoreflect.Name]string{
+		"id":          targetUUID.String(),
+		"petition_id": targetUUID.String(),
+		"poll_id":     targetUUID.String(),
+		"proposal_id": targetUUID.String(),
+		"city_id":     cityID.String(),
+	} {
+		if fd := fields.ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+			msg.Set(fd, protoreflect.ValueOfString(value))
+		}
+	}
+	return msg.Interface()
+}
+
+func callerContext(user *interceptors.UserData) context.Context {
+	ctx := context.WithValue(context.Background(), interceptors.ServiceCtxKey, interceptors.ServiceData{Name: "gateway"})
+	if user != nil {
+		ctx = context.WithValue(ctx, interceptors.UserCtxKey, *user)
+	}
+	return ctx
+}
+
+func TestEveryMethodHasExpectedAccess(t *testing.T) {
+	methods := registeredMethods(t)
+	if len(methods) != len(expected) {
+		t.Errorf("%d methods registered, %d listed in expected", len(methods), len(expected))
+	}
+
+	for method, md := range methods {
+		want, ok := expected[method]
+		if !ok {
+			t.Errorf("%s: no expected access, add it to the test and to rules.go", method)
+			continue
+		}
+		if _, ok := rules[method]; !ok {
+			t.Errorf("%s: no rule, every call to it is denied", method)
+			continue
+		}
+
+		for name, user := range callers {
+			t.Run(method+"/"+name, func(t *testing.T) {
+				owners := &fakeOwners{}
+				err := newTestPolicy(owners).Authorize(callerContext(user), method, request(t, md))
+
+				allowed := false
+				for _, a := range want.allowed {
+					allowed = allowed || a == name
+				}
+				switch code := status.Code(err); {
+				case allowed && err != nil:
+					t.Fatalf("denied: %v", err)
+				case !allowed && code != codes.PermissionDenied:
+					t.Fatalf("code = %s (%v), want PermissionDenied", code, err)
+				}
+
+				// без нужной роли вызов отклоняется до загрузки цели, поэтому сущность сверяется у допущенных
+				if want.entity != "" && allowed && (len(owners.loaded) != 1 || owners.loaded[0] != want.entity) {
+					t.Fatalf("loaded %v, want %s", owners.loaded, want.entity)
+				}
+				if want.entity == "" && len(owners.loaded) != 0 {
+					t.Fatalf("loaded %v for a rule without a target entity", owners.loaded)
+				}
+			})
+		}
+	}
+}
+
+// Адресат без address_to_id — власти города цели.
+func TestAddresseeIsCityGovWithoutAddressTo(t *testing.T) {
+	owners := ownersFunc(func() models.Petition {
+		return models.Petition{CityID: cityID, InitiatorID: initiatorID}
+	})
+	p := newTestPolicy(owners)
+	req := &svc.AnswerPetitionRequest{PetitionId: targetUUID.String()}
+
+	for name, code := range map[string]codes.Code{
+		"city gov of target": codes.OK,
+		"addressee":          codes.PermissionDenied,
+		"city moderator":     codes.PermissionDenied,
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := p.Authorize(callerContext(callers[name]), svc.UserService_AnswerPetition_FullMethodName, req)
+			if status.Code(err) != code {
+				t.Fatalf("code = %s (%v), want %s", status.Code(err), err, code)
+			}
+		})
+	}
+}
+
+// Метод без правила запрещён любому вызывающему, в том числе модератору.
+func TestMethodWithoutRuleIsDenied(t *testing.T) {
+	p := newTestPolicy(&fakeOwners{})
+	p.rules = map[string]Rule{}
+
+	for _, method := range []string{
+		"/voting.v1.UserService/Unknown",
+		svc.UserService_GetPoll_FullMethodName,
+		svc.AdminService_PublishPoll_FullMethodName,
+	} {
+		for name, user := range callers {
+			t.Run(method+"/"+name, func(t *testing.T) {
+				err := p.Authorize(callerContext(user), method, &svc.GetPollRequest{PollId: targetUUID.String()})
+				if status.Code(err) != codes.PermissionDenied {
+					t.Fatalf("code = %s (%v), want PermissionDenied", status.Code(err), err)
+				}
+			})
+		}
+	}
+}
+
+func TestPublicMethodsSkipPolicy(t *testing.T) {
+	p := newTestPolicy(&fakeOwners{})
+	p.rules = map[string]Rule{}
+
+	if err := p.Authorize(context.Background(), "/grpc.health.v1.Health/Check", nil); err != nil {
+		t.Fatalf("health check denied: %v", err)
+	}
+}
+
+// ownersFunc — Owners с петицией, которую задаёт тест.
+type ownersFunc func() models.Petition
+
+func (f ownersFunc) GetPetition(context.Context, uuid.UUID) (models.Petition, error) {
+	return f(), nil
+}
+
+func (f ownersFunc) GetPoll(context.Context, uuid.UUID) (models.Poll, error) {
+	return models.Poll{}, entities.ErrNotFound
+}
+
+func (f ownersFunc) GetProposal(context.Context, uuid.UUID) (models.Proposal, error) {
+	return models.Proposal{}, entities.ErrNotFound
+}
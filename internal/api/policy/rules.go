@@ -0,0 +1,122 @@
+package policy
+
+import (
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+)
+
+// rules — кто может вызывать метод. Метод без правила запрещён, поэтому новый RPC должен попасть сюда;
+// переходы статусов дополнительно проверяются в entities.
+var rules = map[string]Rule{
+	// ---------- UserService: чтение, доступно и сервисным токенам
+	svc.UserService_GetPetition_FullMethodName:        anyCaller,
+	svc.UserService_ListPetitions_FullMethodName:      anyCaller,
+	svc.UserService_GetPoll_FullMethodName:            anyCaller,
+	svc.UserService_ListPolls_FullMethodName:          anyCaller,
+	svc.UserService_GetPollResults_FullMethodName:     anyCaller,
+	svc.UserService_WatchPollTally_FullMethodName:     anyCaller,
+	svc.UserService_GetProposal_FullMethodName:        anyCaller,
+	svc.UserService_ListProposals_FullMethodName:      anyCaller,
+	svc.UserService_WatchProposalTally_FullMethodName: anyCaller,
+
+	// ---------- UserService: действия от имени любого пользователя
+	svc.UserService_CreatePetition_FullMethodName:      anyUser,
+	svc.UserService_SignPetition_FullMethodName:        anyUser,
+	svc.UserService_UnsignPetition_FullMethodName:      anyUser,
+	svc.UserService_GetMySignature_FullMethodName:      anyUser,
+	svc.UserService_CreatePoll_FullMethodName:          anyUser,
+	svc.UserService_VotePoll_FullMethodName:            anyUser,
+	svc.UserService_RetractPollVote_FullMethodName:     anyUser,
+	svc.UserService_GetMyPollVote_FullMethodName:       anyUser,
+	svc.UserService_CreateProposal_FullMethodName:      anyUser,
+	svc.UserService_VoteProposal_FullMethodName:        anyUser,
+	svc.UserService_RetractProposalVote_FullMethodName: anyUser,
+	svc.UserService_GetMyProposalVote_FullMethodName:   anyUser,
+
+	// ---------- UserService: действия инициатора и адресата
+	svc.UserService_WithdrawMyPetition_FullMethodName: {
+		Target:    Target{Entity: models.EntityPetition, Field: "petition_id"},
+		Relations: Initiator,
+	},
+	svc.UserService_AnswerPetition_FullMethodName: {
+		Target:    Target{Entity: models.EntityPetition, Field: "petition_id"},
+		Relations: Addressee,
+	},
+	svc.UserService_WithdrawMyPoll_FullMethodName: {
+		Target:    Target{Entity: models.EntityPoll, Field: "poll_id"},
+		Relations: Initiator,
+	},
+	svc.UserService_AddPollOption_FullMethodName: {
+		Target:    Target{Entity: models.EntityPoll, Field: "poll_id"},
+		Relations: Initiator,
+	},
+	svc.UserService_EditPollOption_FullMethodName: {
+		Target:    Target{Entity: models.EntityPoll, Field: "poll_id"},
+		Relations: Initiator,
+	},
+	svc.UserService_RemovePollOption_FullMethodName: {
+		Target:    Target{Entity: models.EntityPoll, Field: "poll_id"},
+		Relations: Initiator,
+	},
+	svc.UserService_ReorderPollOptions_FullMethodName: {
+		Target:    Target{Entity: models.EntityPoll, Field: "poll_id"},
+		Relations: Initiator,
+	},
+	svc.UserService_WithdrawMyProposal_FullMethodName: {
+		Target:    Target{Entity: models.EntityProposal, Field: "proposal_id"},
+		Relations: Initiator,
+	},
+	svc.UserService_AnswerProposal_FullMethodName: {
+		Target:    Target{Entity: models.EntityProposal, Field: "proposal_id"},
+		Relations: Addressee,
+	},
+
+	// ---------- AdminService: petitions
+	svc.AdminService_ListPendingPetitions_FullMethodName:    moderatorOfCity,
+	svc.AdminService_PublishPetition_FullMethodName:         moderatorOf(models.EntityPetition),
+	svc.AdminService_DeclinePetition_FullMethodName:         moderatorOf(models.EntityPetition),
+	svc.AdminService_WithdrawPetition_FullMethodName:        moderatorOf(models.EntityPetition),
+	svc.AdminService_EditPetition_FullMethodName:            moderatorOf(models.EntityPetition),
+	svc.AdminService_DeletePetitionSignature_FullMethodName: moderatorOf(models.EntityPetition),
+
+	// ---------- AdminService: polls
+	svc.AdminService_ListPendingPolls_FullMethodName: moderatorOfCity,
+	svc.AdminService_PublishPoll_FullMethodName:      moderatorOf(models.EntityPoll),
+	svc.AdminService_DeclinePoll_FullMethodName:      moderatorOf(models.EntityPoll),
+	svc.AdminService_WithdrawPoll_FullMethodName:     moderatorOf(models.EntityPoll),
+	svc.AdminService_EditPoll_FullMethodName:         moderatorOf(models.EntityPoll),
+	svc.AdminService_DeletePollVote_FullMethodName:   moderatorOf(models.EntityPoll),
+
+	// ---------- AdminService: proposals
+	svc.AdminService_ListPendingProposals_FullMethodName: moderatorOfCity,
+	svc.AdminService_PublishProposal_FullMethodName:      moderatorOf(models.EntityProposal),
+	svc.AdminService_DeclineProposal_FullMethodName:      moderatorOf(models.EntityProposal),
+	svc.AdminService_WithdrawProposal_FullMethodName:     moderatorOf(models.EntityProposal),
+	svc.AdminService_EditProposal_FullMethodName:         moderatorOf(models.EntityProposal),
+	svc.AdminService_DeleteProposalVote_FullMethodName:   moderatorOf(models.EntityProposal),
+
+	// ---------- AdminService: moderation log
+	svc.AdminService_ListModerationHistory_FullMethodName: {
+		Roles: []string{entities.RoleModerator},
+	},
+}
+
+var (
+	anyCaller = Rule{AnyCaller: true}
+	anyUser   = Rule{}
+)
+
+var moderatorOfCity = Rule{
+	Roles:     []string{entities.RoleModerator},
+	Target:    Target{Entity: EntityCity, Field: "city_id"},
+	Relations: CityModerator,
+}
+
+func moderatorOf(entity string) Rule {
+	return Rule{
+		Roles:     []string{entities.RoleModerator},
+		Target:    Target{Entity: entity, Field: "id"},
+		Relations: CityModerator,
+	}
+}
@@ -0,0 +1,168 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// RetryAfterHeader — метаданные ответа с числом секунд до следующей попытки, как HTTP Retry-After.
+const RetryAfterHeader = "retry-after"
+
+type methodLimits struct {
+	perUser Limit
+	perIP   Limit
+}
+
+type Interceptor struct {
+	limiter        Limiter
+	log            *logrus.Logger
+	methods        map[string]methodLimits
+	trustedProxies int
+	streams        *streamCounter
+	failOpen       bool
+}
+
+func NewInterceptor(cfg config.RateLimitConfig, limiter Limiter, log *logrus.Logger) Interceptor {
+	methods := make(map[string]methodLimits, len(cfg.Methods))
+	for _, m := range cfg.Methods {
+		methods[m.Method] = methodLimits{
+			perUser: newLimit(m.PerUser),
+			perIP:   newLimit(m.PerIP),
+		}
+	}
+
+	return Interceptor{
+		limiter:        limiter,
+		log:            log,
+		methods:        methods,
+		trustedProxies: max(cfg.TrustedProxies, 0),
+		streams:        newStreamCounter(cfg.MaxStreams),
+		failOpen:       cfg.FailOpen,
+	}
+}
+
+// Unary limits the configured methods; it has to run after the auth interceptor to see the user.
+func (i Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := i.limit(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream limits how often the configured streams are opened and how many streams one caller keeps open at once.
+func (i Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		if err := i.limit(ctx, info.FullMethod); err != nil {
+			return err
+		}
+
+		release, ok := i.streams.acquire(i.subject(ctx))
+		if !ok {
+			return ape.WithMessage(ape.ErrRateLimited, "too many open streams, at most %d per caller", i.streams.max)
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
+
+func (i Interceptor) limit(ctx context.Context, method string) error {
+	limits, ok := i.methods[method]
+	if !ok {
+		return nil
+	}
+
+	if user, ok := ctx.Value(interceptors.UserCtxKey).(interceptors.UserData); ok && limits.perUser.enabled() {
+		if err := i.take(ctx, method, "user:"+user.ID.String(), limits.perUser); err != nil {
+			return err
+		}
+	}
+	if ip := clientIP(ctx, i.trustedProxies); ip != "" && limits.perIP.enabled() {
+		if err := i.take(ctx, method, "ip:"+ip, limits.perIP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subject — кого считать одним вызывающим: пользователя из токена, иначе адрес клиента.
+func (i Interceptor) subject(ctx context.Context) string {
+	if user, ok := ctx.Value(interceptors.UserCtxKey).(interceptors.UserData); ok {
+		return "user:" + user.ID.String()
+	}
+	return "ip:" + clientIP(ctx, i.trustedProxies)
+}
+
+func (i Interceptor) take(ctx context.Context, method, subject string, limit Limit) error {
+	allowed, retryAfter, err := i.limiter.Allow(ctx, "ratelimit:"+method+":"+subject, limit)
+	if err != nil {
+		// без лимитов голосование открыто для накрутки, поэтому пропускать запросы можно только явно
+		entry := i.log.WithError(err).WithField("method", method)
+		if i.failOpen {
+			entry.Error("rate limiter is unavailable, request is let through")
+			return nil
+		}
+		entry.Error("rate limiter is unavailable, request is rejected")
+		return ape.Wrap(ape.WithMessage(ape.ErrUnavailable, "rate limiter is unavailable, retry later"), err)
+	}
+	if allowed {
+		return nil
+	}
+
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(seconds)))
+
+	return ape.WithMessage(ape.ErrRateLimited, "too many requests, retry after %s", time.Duration(seconds)*time.Second)
+}
+
+// clientIP — адрес клиента. X-Forwarded-For учитывается только от локального прокси (HTTP gateway).
+// Gateway дописывает в конец списка адрес, с которого к нему пришли; всё левее мог подставить сам клиент.
+// Перед gateway может стоять trustedProxies балансировщиков, каждый из которых тоже дописывает адрес,
+// поэтому клиент — запись trustedProxies от конца. Если записей меньше, берётся самая левая: значит,
+// запрос пришёл в обход части прокси.
+func clientIP(ctx context.Context, trustedProxies int) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, value := range md.Get("x-forwarded-for") {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	if len(hops) == 0 {
+		return host
+	}
+	return hops[max(len(hops)-1-trustedProxies, 0)]
+}
@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded []string
+		trusted   int
+		want      string
+	}{
+		{
+			name: "direct client",
+			peer: "203.0.113.7:5000",
+			want: "203.0.113.7",
+		},
+		{
+			name:      "direct client cannot forge the header",
+			peer:      "203.0.113.7:5000",
+			forwarded: []string{"198.51.100.1"},
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "gateway without proxies",
+			peer:      "127.0.0.1:40000",
+			forwarded: []string{"203.0.113.7"},
+			want:      "203.0.113.7",
+		},
+		{
+			// клиент подставил свой X-Forwarded-For, gateway дописал реальный адрес в конец
+			name:      "forged entries before the gateway hop are ignored",
+			peer:      "127.0.0.1:40000",
+			forwarded: []string{"198.51.100.1, 198.51.100.2, 203.0.113.7"},
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "one trusted proxy in front of the gateway",
+			peer:      "[::1]:40000",
+			forwarded: []string{"198.51.100.1, 203.0.113.7, 10.0.0.2"},
+			trusted:   1,
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "several header values",
+			peer:      "127.0.0.1:40000",
+			forwarded: []string{"198.51.100.1", "203.0.113.7, 10.0.0.2"},
+			trusted:   1,
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "shorter chain than trusted proxies",
+			peer:      "127.0.0.1:40000",
+			forwarded: []string{"203.0.113.7"},
+			trusted:   3,
+			want:      "203.0.113.7",
+		},
+		{
+			name: "gateway without the header",
+			peer: "127.0.0.1:40000",
+			want: "127.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			md := metadata.MD{}
+			for _, v := range tt.forwarded {
+				md.Append("x-forwarded-for", v)
+			}
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			if got := clientIP(ctx, tt.trusted); got != tt.want {
+				t.Fatalf("clientIP = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// brokenLimiter — бэкенд, до которого нельзя достучаться.
+type brokenLimiter struct{}
+
+func (brokenLimiter) Allow(context.Context, string, Limit) (bool, time.Duration, error) {
+	return false, 0, errors.New("dial tcp: connection refused")
+}
+
+func TestUnavailableLimiter(t *testing.T) {
+	const method = "/voting.v1.UserService/VotePoll"
+
+	tests := []struct {
+		name     string
+		failOpen bool
+		code     codes.Code
+	}{
+		{name: "fail closed by default", code: codes.Unavailable},
+		{name: "fail open", failOpen: true, code: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, hook := logtest.NewNullLogger()
+			cfg := config.RateLimitConfig{
+				FailOpen: tt.failOpen,
+				Methods: []config.MethodRateLimit{{
+					Method: method,
+					PerIP:  config.RateLimit{Rate: 10, Per: time.Minute},
+				}},
+			}
+			interceptor := NewInterceptor(cfg, brokenLimiter{}, log).Unary()
+
+			addr := &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 5000}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			handled := false
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+				handled = true
+				return nil, nil
+			})
+
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s (%v), want %s", code, err, tt.code)
+			}
+			if handled != tt.failOpen {
+				t.Fatalf("handler ran = %v, want %v", handled, tt.failOpen)
+			}
+			if e := hook.LastEntry(); e == nil || e.Level != logrus.ErrorLevel {
+				t.Fatalf("backend failure logged as %v, want an error entry", e)
+			}
+		})
+	}
+}
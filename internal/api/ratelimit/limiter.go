@@ -0,0 +1,66 @@
+// Package ratelimit throttles RPC calls with token buckets keyed by user and client IP.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+// Limit is a token bucket: Rate tokens are restored every Per, at most Burst are kept.
+type Limit struct {
+	Rate  int
+	Per   time.Duration
+	Burst int
+}
+
+func newLimit(cfg config.RateLimit) Limit {
+	l := Limit{Rate: cfg.Rate, Per: cfg.Per, Burst: cfg.Burst}
+	if l.Per <= 0 {
+		l.Per = time.Second
+	}
+	if l.Burst <= 0 {
+		l.Burst = l.Rate
+	}
+	return l
+}
+
+func (l Limit) enabled() bool {
+	return l.Rate > 0
+}
+
+// perSecond — скорость восполнения в токенах за секунду.
+func (l Limit) perSecond() float64 {
+	return float64(l.Rate) / l.Per.Seconds()
+}
+
+// Limiter takes one token from the bucket under key. When the bucket is empty it reports
+// how long to wait for the next token.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// NewLimiter builds the backend chosen in rate_limit.backend.
+func NewLimiter(cfg config.Config) (Limiter, error) {
+	switch cfg.RateLimit.Backend {
+	case "", BackendMemory:
+		return NewMemory(), nil
+	case BackendRedis:
+		client := redis.NewClient(&redis.Options{
+			Addr:     cfg.Database.Redis.Addr,
+			Password: cfg.Database.Redis.Password,
+			DB:       cfg.Database.Redis.DB,
+		})
+		return NewRedis(client), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend %q", cfg.RateLimit.Backend)
+	}
+}
@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeClock — управляемое время для Memory.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestMemory() (*Memory, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)}
+	m := NewMemory()
+	m.now = clock.now
+	return m, clock
+}
+
+// 1000 токенов в секунду = один за миллисекунду; запросы каждые 0.5 мс должны накапливать восполнение.
+func TestMemoryRefillsBelowOneMillisecond(t *testing.T) {
+	m, clock := newTestMemory()
+	limit := Limit{Rate: 1000, Per: time.Second, Burst: 1}
+
+	if ok, _, _ := m.Allow(context.Background(), "k", limit); !ok {
+		t.Fatal("first request must pass")
+	}
+
+	clock.advance(500 * time.Microsecond)
+	if ok, _, _ := m.Allow(context.Background(), "k", limit); ok {
+		t.Fatal("half a token must not be enough")
+	}
+
+	clock.advance(500 * time.Microsecond)
+	if ok, _, _ := m.Allow(context.Background(), "k", limit); !ok {
+		t.Fatal("two half-millisecond gaps must refill one token")
+	}
+}
+
+func TestMemoryRetryAfter(t *testing.T) {
+	m, _ := newTestMemory()
+	limit := Limit{Rate: 2, Per: time.Second, Burst: 1}
+
+	m.Allow(context.Background(), "k", limit)
+	ok, wait, err := m.Allow(context.Background(), "k", limit)
+	if err != nil || ok {
+		t.Fatalf("second request: allowed=%v err=%v, want denied", ok, err)
+	}
+	if wait != 500*time.Millisecond {
+		t.Fatalf("retry after %s, want 500ms", wait)
+	}
+}
+
+func TestMemoryBurstIsCapped(t *testing.T) {
+	m, clock := newTestMemory()
+	limit := Limit{Rate: 1, Per: time.Second, Burst: 2}
+
+	clock.advance(time.Hour)
+	for i := 0; i < 2; i++ {
+		if ok, _, _ := m.Allow(context.Background(), "k", limit); !ok {
+			t.Fatalf("request %d must pass", i+1)
+		}
+	}
+	if ok, _, _ := m.Allow(context.Background(), "k", limit); ok {
+		t.Fatal("bucket must not hold more than burst")
+	}
+}
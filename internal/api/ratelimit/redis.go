@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeToken — та же корзина, что и в Memory, но атомарно в Redis. Время берётся у Redis, в микросекундах,
+// чтобы расхождение часов реплик не влияло на лимит. Ключ живёт, пока корзина не восполнится.
+var takeToken = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) / rate / 1000) + 1)
+
+return {allowed, wait}
+`)
+
+// Redis shares buckets between replicas.
+type Redis struct {
+	client *redis.Client
+}
+
+func NewRedis(client *redis.Client) *Redis {
+	return &Redis{client: client}
+}
+
+func (r *Redis) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	rate := strconv.FormatFloat(limit.perSecond()/1e6, 'f', -1, 64) // токенов в микросекунду
+
+	res, err := takeToken.Run(ctx, r.client, []string{key}, rate, limit.Burst).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("rate limit script for %s: %w", key, err)
+	}
+	if len(res) != 2 {
+		return false, 0, fmt.Errorf("rate limit script for %s: unexpected reply %v", key, res)
+	}
+
+	return res[0] == 1, time.Duration(res[1]) * time.Microsecond, nil
+}
@@ -0,0 +1,41 @@
+package ratelimit
+
+import "sync"
+
+// streamCounter считает открытые стримы по вызывающим. Счётчик локален для реплики:
+// стрим живёт в одном процессе, и закрывается вместе с ним.
+type streamCounter struct {
+	max int // 0 — без ограничения
+
+	mu   sync.Mutex
+	open map[string]int
+}
+
+func newStreamCounter(limit int) *streamCounter {
+	return &streamCounter{max: limit, open: make(map[string]int)}
+}
+
+// acquire занимает место под стрим subject; release надо вызвать, когда стрим закончится.
+func (c *streamCounter) acquire(subject string) (release func(), ok bool) {
+	if c.max <= 0 {
+		return func() {}, true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.open[subject] >= c.max {
+		return nil, false
+	}
+	c.open[subject]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if c.open[subject]--; c.open[subject] == 0 {
+				delete(c.open, subject)
+			}
+		})
+	}, true
+}
@@ -0,0 +1,40 @@
+package ratelimit
+
+import "testing"
+
+func TestStreamCounter(t *testing.T) {
+	c := newStreamCounter(2)
+
+	release1, ok := c.acquire("user:a")
+	if !ok {
+		t.Fatal("first stream must be allowed")
+	}
+	if _, ok := c.acquire("user:a"); !ok {
+		t.Fatal("second stream must be allowed")
+	}
+	if _, ok := c.acquire("user:a"); ok {
+		t.Fatal("third concurrent stream must be rejected")
+	}
+	if _, ok := c.acquire("user:b"); !ok {
+		t.Fatal("another caller has its own limit")
+	}
+
+	// повторный release не освобождает чужое место
+	release1()
+	release1()
+	if _, ok := c.acquire("user:a"); !ok {
+		t.Fatal("closed stream must free its place")
+	}
+	if _, ok := c.acquire("user:a"); ok {
+		t.Fatal("double release must not free a second place")
+	}
+}
+
+func TestStreamCounterUnlimited(t *testing.T) {
+	c := newStreamCounter(0)
+	for i := 0; i < 100; i++ {
+		if _, ok := c.acquire("user:a"); !ok {
+			t.Fatalf("stream %d rejected without a limit", i+1)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"github.com/google/uuid"
+)
+
+// moderation — общий разбор запросов модерации: модератор из токена и ID сущности.
+func moderation(ctx context.Context, id string) (entities.Actor, uuid.UUID, error) {
+	moderator, err := actor(ctx)
+	if err != nil {
+		return entities.Actor{}, uuid.Nil, err
+	}
+	entityID, err := parseID("id", id)
+	if err != nil {
+		return entities.Actor{}, uuid.Nil, err
+	}
+	return moderator, entityID, nil
+}
+
+func pendingRequest(ctx context.Context, req *svc.ListPendingRequest) (entities.Actor, uuid.UUID, entities.PageRequest, error) {
+	moderator, err := actor(ctx)
+	if err != nil {
+		return entities.Actor{}, uuid.Nil, entities.PageRequest{}, err
+	}
+	cityID, err := parseID("city_id", req.CityId)
+	if err != nil {
+		return entities.Actor{}, uuid.Nil, entities.PageRequest{}, err
+	}
+	return moderator, cityID, page(req.Limit, req.PageToken), nil
+}
+
+func contentEdit(req *svc.EditContentRequest) entities.ContentEdit {
+	return entities.ContentEdit{
+		Title:       req.Title,
+		Description: req.Description,
+	}
+}
+
+// ---------- Petitions
+
+func (s *Service) ListPendingPetitions(ctx context.Context, req *svc.ListPendingRequest) (*svc.ListPetitionsResponse, error) {
+	moderator, cityID, pg, err := pendingRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	petitions, total, next, err := s.app.PetitionsQueue(ctx, moderator, cityID, pg)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &svc.ListPetitionsResponse{Total: total, NextPageToken: next}
+	for _, p := range petitions {
+		resp.Petitions = append(resp.Petitions, petitionResponse(p))
+	}
+	return resp, nil
+}
+
+func (s *Service) PublishPetition(ctx context.Context, req *svc.ModerateRequest) (*svc.Petition, error) {
+	return s.moderatePetition(ctx, req, models.PetitionStatusPublished)
+}
+
+func (s *Service) DeclinePetition(ctx context.Context, req *svc.ModerateRequest) (*svc.Petition, error) {
+	return s.moderatePetition(ctx, req, models.PetitionStatusDeclined)
+}
+
+func (s *Service) WithdrawPetition(ctx context.Context, req *svc.ModerateRequest) (*svc.Petition, error) {
+	return s.moderatePetition(ctx, req, models.PetitionStatusWithdrawn)
+}
+
+func (s *Service) moderatePetition(ctx context.Context, req *svc.ModerateRequest, status string) (*svc.Petition, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.ModeratePetition(ctx, moderator, id, status, req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+func (s *Service) EditPetition(ctx context.Context, req *svc.EditContentRequest) (*svc.Petition, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.EditPetition(ctx, moderator, id, contentEdit(req), req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+func (s *Service) DeletePetitionSignature(ctx context.Context, req *svc.DeleteUserContributionRequest) (*svc.Petition, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.RemovePetitionSignature(ctx, moderator, id, userID, req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+// ---------- Polls
+
+func (s *Service) ListPendingPolls(ctx context.Context, req *svc.ListPendingRequest) (*svc.ListPollsResponse, error) {
+	moderator, cityID, pg, err := pendingRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	polls, total, next, err := s.app.PollsQueue(ctx, moderator, cityID, pg)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &svc.ListPollsResponse{Total: total, NextPageToken: next}
+	for _, p := range polls {
+		resp.Polls = append(resp.Polls, pollResponse(p))
+	}
+	return resp, nil
+}
+
+func (s *Service) PublishPoll(ctx context.Context, req *svc.ModerateRequest) (*svc.Poll, error) {
+	return s.moderatePoll(ctx, req, models.PollStatusPublished)
+}
+
+func (s *Service) DeclinePoll(ctx context.Context, req *svc.ModerateRequest) (*svc.Poll, error) {
+	return s.moderatePoll(ctx, req, models.PollStatusDeclined)
+}
+
+func (s *Service) WithdrawPoll(ctx context.Context, req *svc.ModerateRequest) (*svc.Poll, error) {
+	return s.moderatePoll(ctx, req, models.PollStatusWithdrawn)
+}
+
+func (s *Service) moderatePoll(ctx context.Context, req *svc.ModerateRequest, status string) (*svc.Poll, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.ModeratePoll(ctx, moderator, id, status, req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) EditPoll(ctx context.Context, req *svc.EditContentRequest) (*svc.Poll, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.EditPoll(ctx, moderator, id, contentEdit(req), req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) DeletePollVote(ctx context.Context, req *svc.DeleteUserContributionRequest) (*svc.Poll, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.RemovePollVotes(ctx, moderator, id, userID, req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+// ---------- Proposals
+
+func (s *Service) ListPendingProposals(ctx context.Context, req *svc.ListPendingRequest) (*svc.ListProposalsResponse, error) {
+	moderator, cityID, pg, err := pendingRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, total, next, err := s.app.ProposalsQueue(ctx, moderator, cityID, pg)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &svc.ListProposalsResponse{Total: total, NextPageToken: next}
+	for _, p := range proposals {
+		resp.Proposals = append(resp.Proposals, proposalResponse(p))
+	}
+	return resp, nil
+}
+
+func (s *Service) PublishProposal(ctx context.Context, req *svc.ModerateRequest) (*svc.Proposal, error) {
+	return s.moderateProposal(ctx, req, models.ProposalStatusPublished)
+}
+
+func (s *Service) DeclineProposal(ctx context.Context, req *svc.ModerateRequest) (*svc.Proposal, error) {
+	return s.moderateProposal(ctx, req, models.ProposalStatusDeclined)
+}
+
+func (s *Service) WithdrawProposal(ctx context.Context, req *svc.ModerateRequest) (*svc.Proposal, error) {
+	return s.moderateProposal(ctx, req, models.ProposalStatusWithdrawn)
+}
+
+func (s *Service) moderateProposal(ctx context.Context, req *svc.ModerateRequest, status string) (*svc.Proposal, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.app.ModerateProposal(ctx, moderator, id, status, req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalResponse(proposal), nil
+}
+
+func (s *Service) EditProposal(ctx context.Context, req *svc.EditContentRequest) (*svc.Proposal, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.app.EditProposal(ctx, moderator, id, contentEdit(req), req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalResponse(proposal), nil
+}
+
+func (s *Service) DeleteProposalVote(ctx context.Context, req *svc.DeleteUserContributionRequest) (*svc.Proposal, error) {
+	moderator, id, err := moderation(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID("user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.app.RemoveProposalVote(ctx, moderator, id, userID, req.Reason)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalResponse(proposal), nil
+}
+
+// ---------- History
+
+func (s *Service) ListModerationHistory(ctx context.Context, req *svc.ListModerationHistoryRequest) (*svc.ListModerationHistoryResponse, error) {
+	moderator, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entityID, err := parseID("entity_id", req.EntityId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, total, next, err := s.app.ModerationHistory(ctx, moderator, entityID, page(req.Limit, req.PageToken))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &svc.ListModerationHistoryResponse{Total: total, NextPageToken: next}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, moderationEntryResponse(e))
+	}
+	return resp, nil
+}
@@ -0,0 +1,10 @@
+package service
+
+import (
+	"github.com/chains-lab/voting-svc/internal/ape"
+)
+
+// grpcError переводит ошибки приложения в статусы gRPC; всё неизвестное скрывается за Internal.
+func grpcError(err error) error {
+	return ape.Translate(err)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) WatchPollTally(req *svc.WatchPollTallyRequest, stream grpc.ServerStreamingServer[svc.PollTally]) error {
+	ctx := stream.Context()
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return err
+	}
+
+	updates, cancel, err := s.app.WatchPollTally(ctx, id)
+	if err != nil {
+		return grpcError(err)
+	}
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case tally := <-updates:
+			resp := &svc.PollTally{
+				PollId:    tally.PollID.String(),
+				UpdatedAt: timestamppb.New(tally.UpdatedAt),
+			}
+			for _, o := range tally.Options {
+				resp.Options = append(resp.Options, pollOptionResponse(o))
+			}
+			if err = stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Service) WatchProposalTally(req *svc.WatchProposalTallyRequest, stream grpc.ServerStreamingServer[svc.ProposalTally]) error {
+	ctx := stream.Context()
+	id, err := parseID("proposal_id", req.ProposalId)
+	if err != nil {
+		return err
+	}
+
+	updates, cancel, err := s.app.WatchProposalTally(ctx, id)
+	if err != nil {
+		return grpcError(err)
+	}
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case tally := <-updates:
+			err = stream.Send(&svc.ProposalTally{
+				ProposalId:   tally.ProposalID.String(),
+				AgreedNum:    int32(tally.AgreedNum),
+				DisagreedNum: int32(tally.DisagreedNum),
+				UpdatedAt:    timestamppb.New(tally.UpdatedAt),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+)
+
+func (s *Service) CreatePetition(ctx context.Context, req *svc.CreatePetitionRequest) (*svc.Petition, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := parseDraft(req.CityId, req.Title, req.Description, req.EndDate, req.Location)
+	if err != nil {
+		return nil, err
+	}
+	addressTo, err := parseOptionalID("address_to_id", req.AddressToId)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.CreatePetition(ctx, user, entities.CreatePetitionInput{
+		Draft:       draft,
+		AddressToID: addressTo,
+		Goal:        int(req.Goal),
+		StopAtGoal:  req.StopAtGoal,
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+func (s *Service) GetPetition(ctx context.Context, req *svc.GetPetitionRequest) (*svc.Petition, error) {
+	id, err := parseID("petition_id", req.PetitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.GetPetition(ctx, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+func (s *Service) ListPetitions(ctx context.Context, req *svc.ListPetitionsRequest) (*svc.ListPetitionsResponse, error) {
+	filter, err := parseListFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	petitions, total, next, err := s.app.ListPetitions(ctx, filter, page(req.Limit, req.PageToken))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &svc.ListPetitionsResponse{Total: total, NextPageToken: next}
+	for _, p := range petitions {
+		resp.Petitions = append(resp.Petitions, petitionResponse(p))
+	}
+	return resp, nil
+}
+
+func (s *Service) SignPetition(ctx context.Context, req *svc.SignPetitionRequest) (*svc.Petition, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("petition_id", req.PetitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.SignPetition(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+func (s *Service) UnsignPetition(ctx context.Context, req *svc.UnsignPetitionRequest) (*svc.Petition, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("petition_id", req.PetitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.UnsignPetition(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+func (s *Service) GetMySignature(ctx context.Context, req *svc.GetMySignatureRequest) (*svc.PetitionSignature, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("petition_id", req.PetitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := s.app.PetitionSignature(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return signatureResponse(signature), nil
+}
+
+func (s *Service) WithdrawMyPetition(ctx context.Context, req *svc.WithdrawMyPetitionRequest) (*svc.Petition, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("petition_id", req.PetitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.WithdrawPetition(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
+
+func (s *Service) AnswerPetition(ctx context.Context, req *svc.AnswerPetitionRequest) (*svc.Petition, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("petition_id", req.PetitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	petition, err := s.app.AnswerPetition(ctx, user, id, req.Approve)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return petitionResponse(petition), nil
+}
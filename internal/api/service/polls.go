@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Service) CreatePoll(ctx context.Context, req *svc.CreatePollRequest) (*svc.Poll, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := parseDraft(req.CityId, req.Title, req.Description, req.EndDate, req.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.CreatePoll(ctx, user, entities.CreatePollInput{
+		Draft:      draft,
+		Type:       req.Type,
+		Secret:     req.Secret,
+		MinChoices: int(req.MinChoices),
+		MaxChoices: int(req.MaxChoices),
+		MaxScore:   int(req.MaxScore),
+		Options:    req.Options,
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) GetPoll(ctx context.Context, req *svc.GetPollRequest) (*svc.Poll, error) {
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.GetPoll(ctx, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) ListPolls(ctx context.Context, req *svc.ListPollsRequest) (*svc.ListPollsResponse, error) {
+	filter, err := parseListFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	polls, total, next, err := s.app.ListPolls(ctx, filter, page(req.Limit, req.PageToken))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &svc.ListPollsResponse{Total: total, NextPageToken: next}
+	for _, p := range polls {
+		resp.Polls = append(resp.Polls, pollResponse(p))
+	}
+	return resp, nil
+}
+
+func (s *Service) VotePoll(ctx context.Context, req *svc.VotePollRequest) (*svc.VotePollResponse, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+	choices, err := parseChoices(req.Choices)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := s.app.VotePoll(ctx, user, id, choices)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &svc.VotePollResponse{Votes: pollVotesResponse(votes)}, nil
+}
+
+func (s *Service) RetractPollVote(ctx context.Context, req *svc.RetractPollVoteRequest) (*emptypb.Empty, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.app.RetractPollVote(ctx, user, id); err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetMyPollVote(ctx context.Context, req *svc.GetMyPollVoteRequest) (*svc.GetMyPollVoteResponse, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+
+	voted, votes, err := s.app.PollVote(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &svc.GetMyPollVoteResponse{Voted: voted, Votes: pollVotesResponse(votes)}, nil
+}
+
+func (s *Service) GetPollResults(ctx context.Context, req *svc.GetPollResultsRequest) (*svc.PollResults, error) {
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.app.PollResults(ctx, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResultsResponse(results), nil
+}
+
+func (s *Service) WithdrawMyPoll(ctx context.Context, req *svc.WithdrawMyPollRequest) (*svc.Poll, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.WithdrawPoll(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) AddPollOption(ctx context.Context, req *svc.AddPollOptionRequest) (*svc.Poll, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.AddPollOption(ctx, user, id, req.Text)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) EditPollOption(ctx context.Context, req *svc.EditPollOptionRequest) (*svc.Poll, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+	optionID, err := parseID("option_id", req.OptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.EditPollOption(ctx, user, id, optionID, req.Text)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) RemovePollOption(ctx context.Context, req *svc.RemovePollOptionRequest) (*svc.Poll, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+	optionID, err := parseID("option_id", req.OptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.RemovePollOption(ctx, user, id, optionID)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
+
+func (s *Service) ReorderPollOptions(ctx context.Context, req *svc.ReorderPollOptionsRequest) (*svc.Poll, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("poll_id", req.PollId)
+	if err != nil {
+		return nil, err
+	}
+	optionIDs, err := parseIDs("option_ids", req.OptionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, err := s.app.ReorderPollOptions(ctx, user, id, optionIDs)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return pollResponse(poll), nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Service) CreateProposal(ctx context.Context, req *svc.CreateProposalRequest) (*svc.Proposal, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := parseDraft(req.CityId, req.Title, req.Description, req.EndDate, req.Location)
+	if err != nil {
+		return nil, err
+	}
+	addressTo, err := parseOptionalID("address_to_id", req.AddressToId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.app.CreateProposal(ctx, user, entities.CreateProposalInput{
+		Draft:       draft,
+		AddressToID: addressTo,
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalResponse(proposal), nil
+}
+
+func (s *Service) GetProposal(ctx context.Context, req *svc.GetProposalRequest) (*svc.Proposal, error) {
+	id, err := parseID("proposal_id", req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.app.GetProposal(ctx, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalResponse(proposal), nil
+}
+
+func (s *Service) ListProposals(ctx context.Context, req *svc.ListProposalsRequest) (*svc.ListProposalsResponse, error) {
+	filter, err := parseListFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, total, next, err := s.app.ListProposals(ctx, filter, page(req.Limit, req.PageToken))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &svc.ListProposalsResponse{Total: total, NextPageToken: next}
+	for _, p := range proposals {
+		resp.Proposals = append(resp.Proposals, proposalResponse(p))
+	}
+	return resp, nil
+}
+
+func (s *Service) VoteProposal(ctx context.Context, req *svc.VoteProposalRequest) (*svc.ProposalVote, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("proposal_id", req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := s.app.VoteProposal(ctx, user, id, req.Agree)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalVoteResponse(vote), nil
+}
+
+func (s *Service) RetractProposalVote(ctx context.Context, req *svc.RetractProposalVoteRequest) (*emptypb.Empty, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("proposal_id", req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.app.RetractProposalVote(ctx, user, id); err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetMyProposalVote(ctx context.Context, req *svc.GetMyProposalVoteRequest) (*svc.ProposalVote, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("proposal_id", req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := s.app.ProposalVote(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalVoteResponse(vote), nil
+}
+
+func (s *Service) WithdrawMyProposal(ctx context.Context, req *svc.WithdrawMyProposalRequest) (*svc.Proposal, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("proposal_id", req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.app.WithdrawProposal(ctx, user, id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalResponse(proposal), nil
+}
+
+func (s *Service) AnswerProposal(ctx context.Context, req *svc.AnswerProposalRequest) (*svc.Proposal, error) {
+	user, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseID("proposal_id", req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.app.AnswerProposal(ctx, user, id, req.Approve)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return proposalResponse(proposal), nil
+}
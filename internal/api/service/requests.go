@@ -0,0 +1,108 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func parseID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, ape.InvalidField(field, "%q is not a valid uuid", value)
+	}
+	return id, nil
+}
+
+// parseIDs — ошибка называет элемент списка, например option_ids[2].
+func parseIDs(field string, values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for i, value := range values {
+		id, err := parseID(fmt.Sprintf("%s[%d]", field, i), value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseOptionalID — пустая строка означает «не задано».
+func parseOptionalID(field, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := parseID(field, value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func parseDraft(cityID, title, description string, endDate *timestamppb.Timestamp, location *svc.Location) (entities.Draft, error) {
+	city, err := parseID("city_id", cityID)
+	if err != nil {
+		return entities.Draft{}, err
+	}
+	if endDate == nil {
+		return entities.Draft{}, ape.InvalidField("end_date", "is required")
+	}
+
+	draft := entities.Draft{
+		CityID:      city,
+		Title:       title,
+		Description: description,
+		EndDate:     endDate.AsTime(),
+	}
+	if location != nil {
+		draft.Location = &models.Location{Lat: location.Lat, Lng: location.Lng}
+	}
+	return draft, nil
+}
+
+func parseListFilter(f *svc.ListFilter) (entities.ListFilter, error) {
+	var filter entities.ListFilter
+	if f == nil {
+		return filter, nil
+	}
+
+	var err error
+	if filter.CityID, err = parseOptionalID("filter.city_id", f.CityId); err != nil {
+		return filter, err
+	}
+	if filter.InitiatorID, err = parseOptionalID("filter.initiator_id", f.InitiatorId); err != nil {
+		return filter, err
+	}
+	filter.Status = f.Status
+	filter.Title = f.Title
+	filter.Query = strings.TrimSpace(f.Query)
+	filter.Language = f.Language
+	if b := f.Bbox; b != nil {
+		filter.BBox = &entities.BBox{MinLng: b.MinLng, MinLat: b.MinLat, MaxLng: b.MaxLng, MaxLat: b.MaxLat}
+	}
+	return filter, nil
+}
+
+func parseChoices(in []*svc.PollChoice) ([]models.PollChoice, error) {
+	choices := make([]models.PollChoice, 0, len(in))
+	for i, c := range in {
+		id, err := parseID(fmt.Sprintf("choices[%d].option_id", i), c.GetOptionId())
+		if err != nil {
+			return nil, err
+		}
+
+		choice := models.PollChoice{OptionID: id}
+		if c.Score != nil {
+			score := int(*c.Score)
+			choice.Score = &score
+		}
+		choices = append(choices, choice)
+	}
+	return choices, nil
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ошибки разбора запроса должны нести те же детали, что и ошибки домена.
+func TestParseErrorsCarryDetails(t *testing.T) {
+	_, idErr := parseIDs("option_ids", []string{"7f1c2a4e-1d55-4b6e-9a36-54c1d1c6b2a0", "nope"})
+	_, draftErr := parseDraft("7f1c2a4e-1d55-4b6e-9a36-54c1d1c6b2a0", "title", "", nil, nil)
+
+	tests := []struct {
+		name  string
+		err   error
+		field string
+	}{
+		{name: "invalid uuid in a list", err: idErr, field: "option_ids[1]"},
+		{name: "missing end date", err: draftErr, field: "end_date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(tt.err)
+			if !ok || st.Code() != codes.InvalidArgument {
+				t.Fatalf("got %v, want InvalidArgument", tt.err)
+			}
+
+			var (
+				reason string
+				fields []string
+			)
+			for _, d := range st.Details() {
+				switch d := d.(type) {
+				case *errdetails.ErrorInfo:
+					reason = d.Reason
+				case *errdetails.BadRequest:
+					for _, v := range d.FieldViolations {
+						fields = append(fields, v.Field)
+					}
+				}
+			}
+			if reason != ape.ReasonInvalidInput {
+				t.Errorf("reason = %q, want %q", reason, ape.ReasonInvalidInput)
+			}
+			if len(fields) != 1 || fields[0] != tt.field {
+				t.Errorf("violations = %v, want [%s]", fields, tt.field)
+			}
+		})
+	}
+}
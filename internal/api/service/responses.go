@@ -0,0 +1,265 @@
+package service
+
+import (
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func petitionResponse(p models.Petition) *svc.Petition {
+	return &svc.Petition{
+		Id:            p.ID.String(),
+		CityId:        p.CityID.String(),
+		Title:         p.Title,
+		Description:   p.Description,
+		InitiatorId:   p.InitiatorID.String(),
+		AddressToId:   optionalID(p.AddressToID),
+		Status:        p.Status,
+		Signatures:    int32(p.Signatures),
+		Goal:          int32(p.Goal),
+		StopAtGoal:    p.StopAtGoal,
+		GoalReachedAt: optionalTime(p.GoalReachedAt),
+		EndDate:       timestamppb.New(p.EndDate),
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+		Location:      locationResponse(p.Location),
+		Rank:          p.Rank,
+		Snippet:       p.Snippet,
+	}
+}
+
+func signatureResponse(s models.PetitionSignature) *svc.PetitionSignature {
+	return &svc.PetitionSignature{
+		Id:         s.ID.String(),
+		PetitionId: s.PetitionID.String(),
+		UserId:     s.UserID.String(),
+		CreatedAt:  timestamppb.New(s.CreatedAt),
+	}
+}
+
+func pollResponse(p models.Poll) *svc.Poll {
+	resp := &svc.Poll{
+		Id:          p.ID.String(),
+		CityId:      p.CityID.String(),
+		Title:       p.Title,
+		Description: p.Description,
+		Status:      p.Status,
+		Type:        p.Type,
+		Secret:      p.Secret,
+		InitiatorId: p.InitiatorID.String(),
+		MinChoices:  int32(p.MinChoices),
+		MaxChoices:  int32(p.MaxChoices),
+		MaxScore:    int32(p.MaxScore),
+		EndDate:     timestamppb.New(p.EndDate),
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		Location:    locationResponse(p.Location),
+		Rank:        p.Rank,
+		Snippet:     p.Snippet,
+	}
+	for _, o := range p.Options {
+		resp.Options = append(resp.Options, pollOptionResponse(o))
+	}
+	return resp
+}
+
+func pollOptionResponse(o models.PollOption) *svc.PollOption {
+	return &svc.PollOption{
+		Id:         o.ID.String(),
+		PollId:     o.PollID.String(),
+		Text:       o.OptionText,
+		VotesCount: int32(o.VotesCount),
+		CreatedAt:  timestamppb.New(o.CreatedAt),
+		Position:   int32(o.Position),
+	}
+}
+
+func pollVotesResponse(votes []models.PollVote) []*svc.PollVote {
+	resp := make([]*svc.PollVote, 0, len(votes))
+	for _, v := range votes {
+		resp = append(resp, &svc.PollVote{
+			Id:        v.ID.String(),
+			PollId:    v.PollID.String(),
+			BallotId:  v.BallotID.String(),
+			OptionId:  v.OptionID.String(),
+			Rank:      optionalInt(v.Rank),
+			Score:     optionalInt(v.Score),
+			CreatedAt: timestamppb.New(v.CreatedAt),
+		})
+	}
+	return resp
+}
+
+func pollResultsResponse(r models.PollResults) *svc.PollResults {
+	resp := &svc.PollResults{
+		PollId:    r.PollID.String(),
+		Type:      r.Type,
+		Approvals: tallyResponse(r.Approvals),
+	}
+	for _, o := range r.Options {
+		resp.Options = append(resp.Options, pollOptionResponse(o))
+	}
+	for _, s := range r.Scores {
+		score := &svc.OptionScore{
+			OptionId:  s.OptionID.String(),
+			Ratings:   int32(s.Ratings),
+			MeanScore: s.MeanScore,
+		}
+		for _, n := range s.Distribution {
+			score.Distribution = append(score.Distribution, int32(n))
+		}
+		resp.Scores = append(resp.Scores, score)
+	}
+
+	if r.Runoff != nil {
+		resp.Runoff = &svc.RunoffResult{
+			Ballots: int32(r.Runoff.Ballots),
+			Winner:  optionalID(r.Runoff.Winner),
+			Tied:    ids(r.Runoff.Tied),
+		}
+		for _, round := range r.Runoff.Rounds {
+			resp.Runoff.Rounds = append(resp.Runoff.Rounds, &svc.RunoffRound{
+				Number:     int32(round.Number),
+				Tally:      tallyResponse(round.Tally),
+				Exhausted:  int32(round.Exhausted),
+				Eliminated: ids(round.Eliminated),
+			})
+		}
+	}
+
+	if r.Schulze != nil {
+		resp.Schulze = &svc.SchulzeResult{
+			Options:         ids(r.Schulze.Options),
+			Pairwise:        matrix(r.Schulze.Pairwise),
+			Strongest:       matrix(r.Schulze.Strongest),
+			Winners:         ids(r.Schulze.Winners),
+			Ranking:         groups(r.Schulze.Ranking),
+			CondorcetWinner: optionalID(r.Schulze.CondorcetWinner),
+			Cycles:          groups(r.Schulze.Cycles),
+		}
+	}
+	return resp
+}
+
+func tallyResponse(tally []models.OptionTally) []*svc.OptionTally {
+	resp := make([]*svc.OptionTally, 0, len(tally))
+	for _, t := range tally {
+		resp = append(resp, &svc.OptionTally{OptionId: t.OptionID.String(), Votes: int32(t.Votes)})
+	}
+	return resp
+}
+
+func proposalResponse(p models.Proposal) *svc.Proposal {
+	resp := &svc.Proposal{
+		Id:           p.ID.String(),
+		CityId:       p.CityID.String(),
+		Title:        p.Title,
+		Description:  p.Description,
+		Status:       p.Status,
+		InitiatorId:  p.InitiatorID.String(),
+		AddressToId:  optionalID(p.AddressToID),
+		AgreedNum:    int32(p.AgreedNum),
+		DisagreedNum: int32(p.DisagreedNum),
+		EndDate:      timestamppb.New(p.EndDate),
+		CreatedAt:    timestamppb.New(p.CreatedAt),
+		UpdatedAt:    timestamppb.New(p.UpdatedAt),
+		Location:     locationResponse(p.Location),
+		Rank:         p.Rank,
+		Snippet:      p.Snippet,
+	}
+	if v := p.Verdict; v != nil {
+		resp.Verdict = &svc.ProposalVerdict{
+			DecidedBy:    v.DecidedBy.String(),
+			DecidedAt:    timestamppb.New(v.DecidedAt),
+			AgreedNum:    int32(v.AgreedNum),
+			DisagreedNum: int32(v.DisagreedNum),
+		}
+	}
+	return resp
+}
+
+func proposalVoteResponse(v models.ProposalVote) *svc.ProposalVote {
+	return &svc.ProposalVote{
+		Id:         v.ID.String(),
+		ProposalId: v.ProposalID.String(),
+		UserId:     v.UserID.String(),
+		Agree:      v.Vote,
+		CreatedAt:  timestamppb.New(v.CreatedAt),
+	}
+}
+
+func moderationEntryResponse(e models.ModerationEntry) *svc.ModerationEntry {
+	resp := &svc.ModerationEntry{
+		Id:           e.ID.String(),
+		ModeratorId:  e.ModeratorID.String(),
+		EntityType:   e.EntityType,
+		EntityId:     e.EntityID.String(),
+		Action:       e.Action,
+		TargetUserId: optionalID(e.TargetUserID),
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+	}
+	if e.Reason != nil {
+		resp.Reason = *e.Reason
+	}
+	return resp
+}
+
+func locationResponse(l *models.Location) *svc.Location {
+	if l == nil {
+		return nil
+	}
+	return &svc.Location{Lat: l.Lat, Lng: l.Lng}
+}
+
+func optionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func optionalTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func optionalInt(n *int) *int32 {
+	if n == nil {
+		return nil
+	}
+	v := int32(*n)
+	return &v
+}
+
+func ids(in []uuid.UUID) []string {
+	out := make([]string, 0, len(in))
+	for _, id := range in {
+		out = append(out, id.String())
+	}
+	return out
+}
+
+func groups(in [][]uuid.UUID) []*svc.OptionGroup {
+	out := make([]*svc.OptionGroup, 0, len(in))
+	for _, g := range in {
+		out = append(out, &svc.OptionGroup{OptionIds: ids(g)})
+	}
+	return out
+}
+
+func matrix(in [][]int) []*svc.MatrixRow {
+	out := make([]*svc.MatrixRow, 0, len(in))
+	for _, row := range in {
+		cells := make([]int32, 0, len(row))
+		for _, c := range row {
+			cells = append(cells, int32(c))
+		}
+		out = append(out, &svc.MatrixRow{Cells: cells})
+	}
+	return out
+}
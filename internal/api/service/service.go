@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/chains-lab/voting-svc/internal/app"
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/chains-lab/voting-svc/pkg/svc"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// Service implements the gRPC services over the application.
+type Service struct {
+	svc.UnimplementedUserServiceServer
+	svc.UnimplementedAdminServiceServer
+
+	cfg config.Config
+	app *app.App
+}
+
+func NewService(cfg config.Config, app *app.App) *Service {
+	return &Service{
+		cfg: cfg,
+		app: app,
+	}
+}
+
+// actor — пользователь, которого auth-интерсептор положил в контекст.
+func actor(ctx context.Context) (entities.Actor, error) {
+	user, ok := ctx.Value(interceptors.UserCtxKey).(interceptors.UserData)
+	if !ok {
+		return entities.Actor{}, ape.WithMessage(ape.ErrUnauthenticated, "user is not authenticated")
+	}
+	return entities.Actor{
+		UserID: user.ID,
+		Roles:  user.Roles,
+		CityID: user.CityID,
+	}, nil
+}
+
+func page(limit uint64, token string) entities.PageRequest {
+	switch {
+	case limit == 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+	return entities.PageRequest{Limit: limit, Token: token}
+}
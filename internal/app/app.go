@@ -1,7 +1,79 @@
 package app
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/live"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/pkg/errors"
+)
 
 type App struct {
-	db *sql.DB
+	db    *sql.DB
+	dbURL string
+
+	petitions  entities.Petitions
+	polls      entities.Polls
+	proposals  entities.Proposals
+	moderation entities.ModerationLog
+
+	pollTallies     *live.Hub[models.PollTally]
+	proposalTallies *live.Hub[models.ProposalTally]
+}
+
+func NewApp(cfg config.Config) (App, error) {
+	db, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return App{}, errors.Wrap(err, "failed to open database")
+	}
+
+	polls := entities.NewPolls(db)
+	proposals := entities.NewProposals(db)
+
+	return App{
+		db:         db,
+		dbURL:      cfg.Database.SQL.URL,
+		petitions:  entities.NewPetitions(db),
+		polls:      polls,
+		proposals:  proposals,
+		moderation: entities.NewModerationLog(db),
+
+		pollTallies:     live.NewHub("poll tally", polls.Tally),
+		proposalTallies: live.NewHub("proposal tally", proposals.Tally),
+	}, nil
+}
+
+// CloseExpired closes every published petition, poll and proposal whose end_date is not after now.
+func (a App) CloseExpired(ctx context.Context, now time.Time) error {
+	if err := a.petitions.CloseExpired(ctx, now); err != nil {
+		return errors.Wrap(err, "closing expired petitions")
+	}
+	if err := a.polls.CloseExpired(ctx, now); err != nil {
+		return errors.Wrap(err, "closing expired polls")
+	}
+	if err := a.proposals.CloseExpired(ctx, now); err != nil {
+		return errors.Wrap(err, "closing expired proposals")
+	}
+	return nil
+}
+
+// Ready reports whether the application can serve requests: the database answers and its schema is current.
+func (a App) Ready(ctx context.Context) error {
+	if err := a.db.PingContext(ctx); err != nil {
+		return errors.Wrap(err, "database is unreachable")
+	}
+
+	pending, err := dbx.PendingMigrations(a.db)
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return errors.Errorf("%d migrations are not applied", pending)
+	}
+	return nil
 }
@@ -0,0 +1,43 @@
+package entities
+
+import "github.com/google/uuid"
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleCityGov   = "city_gov"
+)
+
+// Actor is the user on whose behalf a service method is executed.
+type Actor struct {
+	UserID uuid.UUID
+	Roles  []string
+	CityID *uuid.UUID // city the moderator/city_gov roles are scoped to
+}
+
+func (a Actor) HasRole(role string) bool {
+	for _, r := range a.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ModeratorOf reports whether the actor moderates content of the given city.
+func (a Actor) ModeratorOf(cityID uuid.UUID) bool {
+	return a.HasRole(RoleModerator) && a.CityID != nil && *a.CityID == cityID
+}
+
+// CityGovOf reports whether the actor speaks for the government of the given city.
+func (a Actor) CityGovOf(cityID uuid.UUID) bool {
+	return a.HasRole(RoleCityGov) && a.CityID != nil && *a.CityID == cityID
+}
+
+// AddresseeOf — адресат петиции/предложения; если addressToID NULL, адресат — власти города.
+func (a Actor) AddresseeOf(cityID uuid.UUID, addressToID *uuid.UUID) bool {
+	if addressToID != nil {
+		return a.UserID == *addressToID
+	}
+	return a.CityGovOf(cityID)
+}
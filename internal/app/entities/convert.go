@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+)
+
+func locationModel(lat, lng *float64) *models.Location {
+	if lat == nil || lng == nil {
+		return nil
+	}
+	return &models.Location{Lat: *lat, Lng: *lng}
+}
+
+func geoPoint(l *models.Location) *dbx.GeoPoint {
+	if l == nil {
+		return nil
+	}
+	return &dbx.GeoPoint{Lat: l.Lat, Lng: l.Lng}
+}
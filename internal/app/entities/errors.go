@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"fmt"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+)
+
+// Ошибки бизнес-логики — это доменные ошибки ape, так их причина доходит до клиента без сопоставлений.
+var (
+	ErrNotFound          = ape.ErrNotFound
+	ErrInvalidInput      = ape.ErrInvalidInput
+	ErrForbidden         = ape.ErrForbidden
+	ErrInvalidTransition = ape.ErrInvalidTransition
+	ErrVotingClosed      = ape.ErrClosedForVoting
+	ErrOptionsLocked     = ape.ErrOptionsLocked
+	ErrAlreadySigned     = ape.ErrAlreadySigned
+	ErrInvalidVote       = ape.ErrInvalidVote
+	ErrAlreadyVoted      = ape.ErrAlreadyVoted
+)
+
+// TransitionError is returned when an entity cannot move from its current status to the requested one.
+type TransitionError struct {
+	Entity string
+	From   string
+	To     string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s cannot move from %q to %q", e.Entity, e.From, e.To)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return ErrInvalidTransition
+}
@@ -0,0 +1,132 @@
+package entities
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/google/uuid"
+)
+
+// Длины колонок title/description в petitions, polls, proposals и option_text в poll_options.
+const (
+	maxTitleLen       = 255
+	maxDescriptionLen = 8192
+	maxOptionLen      = 255
+	maxQueryLen       = 256
+)
+
+// Сколько вариантов может быть у опроса.
+const (
+	minPollOptions = 2
+	maxPollOptions = 20
+)
+
+// searchLanguages maps ListFilter.Language to the text search configuration of the query.
+var searchLanguages = map[string]string{
+	"ru": dbx.SearchRussian,
+	"uk": dbx.SearchUkrainian,
+	"en": dbx.SearchEnglish,
+}
+
+// ListFilter narrows the list queries; zero fields do not filter.
+type ListFilter struct {
+	CityID      *uuid.UUID
+	InitiatorID *uuid.UUID
+	Status      string
+	Title       string // substring of the title, case-insensitive
+	// Query is a full-text search over title and description (web search syntax);
+	// matches come ranked by relevance instead of by date.
+	Query string
+	// Language of Query: ru, uk or en; empty searches in all of them.
+	Language string
+	BBox     *BBox
+}
+
+// BBox is a map area in WGS 84 degrees; only entities with a location inside it match.
+type BBox struct {
+	MinLng, MinLat, MaxLng, MaxLat float64
+}
+
+func (f ListFilter) validate() error {
+	if utf8.RuneCountInString(f.Query) > maxQueryLen {
+		return ape.InvalidField("filter.query", "must be at most %d characters", maxQueryLen)
+	}
+	if _, ok := searchLanguages[f.Language]; f.Language != "" && !ok {
+		return ape.InvalidField("filter.language", "must be one of ru, uk, en")
+	}
+	if b := f.BBox; b != nil && (b.MinLat < -90 || b.MaxLat > 90 || b.MinLng < -180 || b.MaxLng > 180 ||
+		b.MinLat > b.MaxLat || b.MinLng > b.MaxLng) {
+		return ape.InvalidField("filter.bbox", "is out of range or empty")
+	}
+	return nil
+}
+
+// searchConfigs — конфигурации для dbx Search; пустой список значит «все языки».
+func (f ListFilter) searchConfigs() []string {
+	if cfg, ok := searchLanguages[f.Language]; ok {
+		return []string{cfg}
+	}
+	return nil
+}
+
+// Draft is the content shared by new petitions, polls and proposals.
+type Draft struct {
+	CityID      uuid.UUID
+	Title       string
+	Description string
+	EndDate     time.Time
+	Location    *models.Location
+}
+
+func (d Draft) validate(now time.Time) error {
+	title := strings.TrimSpace(d.Title)
+	if title == "" || utf8.RuneCountInString(title) > maxTitleLen {
+		return ape.InvalidField("title", "must be 1..%d characters", maxTitleLen)
+	}
+	if utf8.RuneCountInString(d.Description) > maxDescriptionLen {
+		return ape.InvalidField("description", "must be at most %d characters", maxDescriptionLen)
+	}
+	if d.CityID == uuid.Nil {
+		return ape.InvalidField("city_id", "is required")
+	}
+	if !d.EndDate.After(now) {
+		return ape.InvalidField("end_date", "%s is not in the future", d.EndDate)
+	}
+	if l := d.Location; l != nil && (l.Lat < -90 || l.Lat > 90 || l.Lng < -180 || l.Lng > 180) {
+		return ape.InvalidField("location", "%f,%f is out of range", l.Lat, l.Lng)
+	}
+	return nil
+}
+
+// PageRequest is a page of a list: its size and the token returned with the previous page (empty for the first one).
+type PageRequest struct {
+	Limit uint64
+	Token string
+}
+
+// applyPage разбирает токен и ограничивает выборку; берётся на строку больше, чтобы узнать, есть ли следующая страница.
+func applyPage[Q interface {
+	Page(limit uint64, after *dbx.Cursor) (Q, error)
+}](q Q, page PageRequest) (Q, error) {
+	after, err := dbx.DecodeCursor(page.Token)
+	if err == nil {
+		q, err = q.Page(page.Limit+1, after)
+	}
+	if err != nil {
+		return q, ape.InvalidField("page_token", "is malformed or belongs to another list")
+	}
+	return q, nil
+}
+
+// nextPage отрезает лишнюю строку и возвращает токен следующей страницы, если она есть.
+func nextPage[T any](rows []T, page PageRequest, cursor func(T) dbx.Cursor) ([]T, string) {
+	if uint64(len(rows)) <= page.Limit {
+		return rows, ""
+	}
+	rows = rows[:page.Limit]
+	return rows, cursor(rows[len(rows)-1]).Encode()
+}
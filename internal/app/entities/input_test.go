@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Негодный page_token — ошибка клиента: InvalidArgument с нарушением по полю, а не Internal.
+func TestApplyPageRejectsBadTokens(t *testing.T) {
+	asc := dbx.NewPollsQ(nil).OrderByCreatedAsc().Cursor(dbx.Poll{ID: uuid.New(), CreatedAt: time.Now()})
+
+	tests := map[string]struct {
+		q     dbx.PollsQ
+		token string
+	}{
+		"garbage":         {q: dbx.NewPollsQ(nil), token: "garbage!"},
+		"tampered":        {q: dbx.NewPollsQ(nil), token: asc.Encode()[:10]},
+		"another sort":    {q: dbx.NewPollsQ(nil).OrderByCreatedDesc(), token: asc.Encode()},
+		"another ranking": {q: dbx.NewPollsQ(nil).OrderByRank(), token: asc.Encode()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := applyPage(tt.q, PageRequest{Limit: 10, Token: tt.token})
+
+			st, ok := status.FromError(err)
+			if !ok || st.Code() != codes.InvalidArgument {
+				t.Fatalf("err = %v, want InvalidArgument", err)
+			}
+			var field string
+			for _, d := range st.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok && len(br.FieldViolations) == 1 {
+					field = br.FieldViolations[0].Field
+				}
+			}
+			if field != "page_token" {
+				t.Fatalf("violation on %q, want page_token", field)
+			}
+			if !errors.Is(err, ape.ErrInvalidInput) {
+				t.Fatalf("err = %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+}
+
+func TestApplyPageAcceptsOwnToken(t *testing.T) {
+	q := dbx.NewPollsQ(nil).OrderByCreatedDesc()
+	token := q.Cursor(dbx.Poll{ID: uuid.New(), CreatedAt: time.Now()}).Encode()
+
+	if _, err := applyPage(q, PageRequest{Limit: 10, Token: token}); err != nil {
+		t.Fatalf("applyPage: %v", err)
+	}
+}
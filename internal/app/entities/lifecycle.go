@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"fmt"
+	"sort"
+)
+
+// party — в каком качестве актор участвует в жизненном цикле сущности.
+type party uint8
+
+const (
+	partyInitiator party = 1 << iota
+	partyModerator
+	partyAddressee
+)
+
+// transitions maps the current status to the statuses reachable from it and the parties allowed to make each move.
+type transitions map[string]map[string]party
+
+func (t transitions) check(entity, from, to string, who party) error {
+	allowed, ok := t[from][to]
+	if !ok {
+		return &TransitionError{Entity: entity, From: from, To: to}
+	}
+	if allowed&who == 0 {
+		return fmt.Errorf("%w: %s %s -> %s", ErrForbidden, entity, from, to)
+	}
+	return nil
+}
+
+// available returns the statuses the given parties may move the entity to from its current status.
+func (t transitions) available(from string, who party) []string {
+	var out []string
+	for to, allowed := range t[from] {
+		if allowed&who != 0 {
+			out = append(out, to)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
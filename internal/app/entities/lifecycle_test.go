@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/chains-lab/voting-svc/internal/app/models"
+)
+
+var parties = map[string]party{
+	"initiator": partyInitiator,
+	"moderator": partyModerator,
+	"addressee": partyAddressee,
+}
+
+// move — разрешённый переход и стороны, которым он доступен.
+type move struct {
+	from, to string
+	by       []string
+}
+
+// Жизненные циклы записаны здесь заново, а не взяты из таблиц переходов: тест сверяет с ними
+// каждую тройку (from, to, сторона), так что любое изменение таблицы должно попасть и сюда.
+var lifecycles = []struct {
+	entity      string
+	transitions transitions
+	statuses    []string
+	moves       []move
+}{
+	{
+		entity:      "petition",
+		transitions: petitionTransitions,
+		statuses: []string{
+			models.PetitionStatusProcessed, models.PetitionStatusDeclined, models.PetitionStatusPublished,
+			models.PetitionStatusWithdrawn, models.PetitionStatusApproved, models.PetitionStatusRejected,
+			models.PetitionStatusExpired, models.PetitionStatusAwaitingResponse,
+		},
+		moves: []move{
+			{models.PetitionStatusProcessed, models.PetitionStatusPublished, []string{"moderator"}},
+			{models.PetitionStatusProcessed, models.PetitionStatusDeclined, []string{"moderator"}},
+			{models.PetitionStatusProcessed, models.PetitionStatusWithdrawn, []string{"initiator", "moderator"}},
+			{models.PetitionStatusPublished, models.PetitionStatusWithdrawn, []string{"initiator", "moderator"}},
+			{models.PetitionStatusPublished, models.PetitionStatusApproved, []string{"addressee"}},
+			{models.PetitionStatusPublished, models.PetitionStatusRejected, []string{"addressee"}},
+			{models.PetitionStatusAwaitingResponse, models.PetitionStatusApproved, []string{"addressee"}},
+			{models.PetitionStatusAwaitingResponse, models.PetitionStatusRejected, []string{"addressee"}},
+		},
+	},
+	{
+		entity:      "poll",
+		transitions: pollTransitions,
+		statuses: []string{
+			models.PollStatusProcessed, models.PollStatusDeclined, models.PollStatusPublished,
+			models.PollStatusWithdrawn, models.PollStatusClosed,
+		},
+		moves: []move{
+			{models.PollStatusProcessed, models.PollStatusPublished, []string{"moderator"}},
+			{models.PollStatusProcessed, models.PollStatusDeclined, []string{"moderator"}},
+			{models.PollStatusProcessed, models.PollStatusWithdrawn, []string{"initiator", "moderator"}},
+			{models.PollStatusPublished, models.PollStatusWithdrawn, []string{"initiator", "moderator"}},
+		},
+	},
+	{
+		entity:      "proposal",
+		transitions: proposalTransitions,
+		statuses: []string{
+			models.ProposalStatusProcessed, models.ProposalStatusDeclined, models.ProposalStatusPublished,
+			models.ProposalStatusWithdrawn, models.ProposalStatusApproved, models.ProposalStatusRejected,
+			models.ProposalStatusClosed,
+		},
+		moves: []move{
+			{models.ProposalStatusProcessed, models.ProposalStatusPublished, []string{"moderator"}},
+			{models.ProposalStatusProcessed, models.ProposalStatusDeclined, []string{"moderator"}},
+			{models.ProposalStatusProcessed, models.ProposalStatusWithdrawn, []string{"initiator", "moderator"}},
+			{models.ProposalStatusPublished, models.ProposalStatusWithdrawn, []string{"initiator", "moderator"}},
+			{models.ProposalStatusPublished, models.ProposalStatusApproved, []string{"addressee"}},
+			{models.ProposalStatusPublished, models.ProposalStatusRejected, []string{"addressee"}},
+			{models.ProposalStatusClosed, models.ProposalStatusApproved, []string{"addressee"}},
+			{models.ProposalStatusClosed, models.ProposalStatusRejected, []string{"addressee"}},
+		},
+	},
+}
+
+func TestTransitionsCheck(t *testing.T) {
+	for _, lc := range lifecycles {
+		t.Run(lc.entity, func(t *testing.T) {
+			edges := map[[2]string][]string{}
+			for _, m := range lc.moves {
+				edges[[2]string{m.from, m.to}] = m.by
+			}
+
+			for _, from := range lc.statuses {
+				for _, to := range lc.statuses {
+					by, edge := edges[[2]string{from, to}]
+					for name, who := range parties {
+						err := lc.transitions.check(lc.entity, from, to, who)
+
+						var transitionErr *TransitionError
+						switch {
+						case !edge:
+							// перехода нет ни для кого: это ошибка перехода, а не доступа
+							if !errors.As(err, &transitionErr) || !errors.Is(err, ErrInvalidTransition) {
+								t.Errorf("%s -> %s by %s: err = %v, want a transition error", from, to, name, err)
+							}
+						case slices.Contains(by, name):
+							if err != nil {
+								t.Errorf("%s -> %s by %s: %v", from, to, name, err)
+							}
+						default:
+							if !errors.Is(err, ErrForbidden) {
+								t.Errorf("%s -> %s by %s: err = %v, want forbidden", from, to, name, err)
+							}
+						}
+					}
+				}
+			}
+
+			// в таблице нет переходов сверх перечисленных, в том числе из статусов, которых тест не знает
+			for from, tos := range lc.transitions {
+				for to := range tos {
+					if _, ok := edges[[2]string{from, to}]; !ok {
+						t.Errorf("unexpected transition %s -> %s", from, to)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTransitionsAvailable(t *testing.T) {
+	for _, lc := range lifecycles {
+		t.Run(lc.entity, func(t *testing.T) {
+			for _, from := range lc.statuses {
+				for name, who := range parties {
+					var want []string
+					for _, m := range lc.moves {
+						if m.from == from && slices.Contains(m.by, name) {
+							want = append(want, m.to)
+						}
+					}
+					sort.Strings(want)
+
+					if got := lc.transitions.available(from, who); !reflect.DeepEqual(got, want) {
+						t.Errorf("available(%s, %s) = %v, want %v", from, name, got, want)
+					}
+				}
+			}
+
+			// модератор, который сам создал сущность, может всё, что может каждая из его ролей
+			both := partyInitiator | partyModerator
+			got := lc.transitions.available(lc.statuses[0], both)
+			var want []string
+			for _, m := range lc.moves {
+				if m.from == lc.statuses[0] && (slices.Contains(m.by, "initiator") || slices.Contains(m.by, "moderator")) {
+					want = append(want, m.to)
+				}
+			}
+			sort.Strings(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("available(%s, initiator|moderator) = %v, want %v", lc.statuses[0], got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/google/uuid"
+)
+
+const maxReasonLen = 1024
+
+type moderationLogQ interface {
+	New() dbx.ModerationLogQ
+
+	Insert(ctx context.Context, in dbx.ModerationEntry) error
+	Get(ctx context.Context) (dbx.ModerationEntry, error)
+	Select(ctx context.Context) ([]dbx.ModerationEntry, error)
+
+	FilterEntityID(entityID uuid.UUID) dbx.ModerationLogQ
+	FilterModeratorID(moderatorID uuid.UUID) dbx.ModerationLogQ
+	OrderByCreatedDesc() dbx.ModerationLogQ
+
+	Count(ctx context.Context) (uint64, error)
+	Page(limit uint64, after *dbx.Cursor) (dbx.ModerationLogQ, error)
+	Cursor(r dbx.ModerationEntry) dbx.Cursor
+}
+
+// ContentEdit is a moderator's correction of a petition, poll or proposal; nil fields stay unchanged.
+type ContentEdit struct {
+	Title       *string
+	Description *string
+}
+
+func (e ContentEdit) validate() error {
+	if e.Title == nil && e.Description == nil {
+		return fmt.Errorf("%w: nothing to edit", ErrInvalidInput)
+	}
+	if e.Title != nil {
+		title := strings.TrimSpace(*e.Title)
+		if title == "" || utf8.RuneCountInString(title) > maxTitleLen {
+			return ape.InvalidField("title", "must be 1..%d characters", maxTitleLen)
+		}
+	}
+	if e.Description != nil && utf8.RuneCountInString(*e.Description) > maxDescriptionLen {
+		return ape.InvalidField("description", "must be at most %d characters", maxDescriptionLen)
+	}
+	return nil
+}
+
+// moderationStatuses — статусы, в которые модератор переводит сущность, и соответствующие действия журнала.
+// Значения совпадают в petition_status, poll_status и proposal_status.
+var moderationStatuses = map[string]string{
+	models.PetitionStatusPublished: models.ModerationPublish,
+	models.PetitionStatusDeclined:  models.ModerationDecline,
+	models.PetitionStatusWithdrawn: models.ModerationWithdraw,
+}
+
+// moderationAction проверяет целевой статус и причину; отклонение без причины не принимается.
+func moderationAction(status, reason string) (string, error) {
+	action, ok := moderationStatuses[status]
+	if !ok {
+		return "", fmt.Errorf("%w: moderators cannot move content to %q", ErrInvalidInput, status)
+	}
+	if action == models.ModerationDecline && strings.TrimSpace(reason) == "" {
+		return "", ape.InvalidField("reason", "is required when declining")
+	}
+	return action, checkReason(reason)
+}
+
+func checkReason(reason string) error {
+	if utf8.RuneCountInString(reason) > maxReasonLen {
+		return ape.InvalidField("reason", "must be at most %d characters", maxReasonLen)
+	}
+	return nil
+}
+
+func checkModerator(actor Actor, entity string, cityID uuid.UUID) error {
+	if !actor.ModeratorOf(cityID) {
+		return fmt.Errorf("%w: %s of city %s is moderated by its city moderators", ErrForbidden, entity, cityID)
+	}
+	return nil
+}
+
+// moderationEntry — запись журнала; пишется в той же транзакции, что и само действие.
+// Город сущности сохраняется в записи, чтобы история оставалась доступной и без самой сущности.
+func moderationEntry(actor Actor, entityType string, entityID, cityID uuid.UUID, action, reason string, target *uuid.UUID) dbx.ModerationEntry {
+	entry := dbx.ModerationEntry{
+		ID:           uuid.New(),
+		ModeratorID:  actor.UserID,
+		EntityType:   entityType,
+		EntityID:     entityID,
+		CityID:       &cityID,
+		Action:       action,
+		TargetUserID: target,
+		CreatedAt:    time.Now().UTC(),
+	}
+	if reason != "" {
+		entry.Reason = &reason
+	}
+	return entry
+}
+
+// ModerationLog gives moderators read access to the log of moderation actions.
+type ModerationLog struct {
+	queries moderationLogQ
+}
+
+func NewModerationLog(db *sql.DB) ModerationLog {
+	return ModerationLog{
+		queries: dbx.NewModerationLogQ(db),
+	}
+}
+
+// History returns the moderation actions on the entity, newest first.
+// Like the moderation queues, it is open only to moderators of the entity's city; the city is taken
+// from the log itself, so the history outlives the entity. An entity without actions has an empty history.
+func (m ModerationLog) History(ctx context.Context, actor Actor, entityID uuid.UUID, page PageRequest) ([]models.ModerationEntry, uint64, string, error) {
+	if !actor.HasRole(RoleModerator) {
+		return nil, 0, "", fmt.Errorf("%w: moderation history is available to moderators", ErrForbidden)
+	}
+
+	latest, err := m.queries.New().FilterEntityID(entityID).OrderByCreatedDesc().Get(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return []models.ModerationEntry{}, 0, "", nil
+	}
+	if err != nil {
+		return nil, 0, "", err
+	}
+	// город есть у каждой записи, кроме сделанных до его появления о сущностях, удалённых раньше миграции
+	if latest.CityID == nil {
+		return nil, 0, "", fmt.Errorf("%w: city of %s %s is unknown", ErrForbidden, latest.EntityType, entityID)
+	}
+	if err := checkModerator(actor, latest.EntityType, *latest.CityID); err != nil {
+		return nil, 0, "", err
+	}
+
+	q := m.queries.New().FilterEntityID(entityID)
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	q, err = applyPage(q.OrderByCreatedDesc(), page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, err := q.Select(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, next := nextPage(rows, page, q.Cursor)
+
+	entries := make([]models.ModerationEntry, 0, len(rows))
+	for _, e := range rows {
+		entries = append(entries, models.ModerationEntry{
+			ID:           e.ID,
+			ModeratorID:  e.ModeratorID,
+			EntityType:   e.EntityType,
+			EntityID:     e.EntityID,
+			Action:       e.Action,
+			Reason:       e.Reason,
+			TargetUserID: e.TargetUserID,
+			CreatedAt:    e.CreatedAt,
+		})
+	}
+	return entries, total, next, nil
+}
@@ -2,7 +2,13 @@ package entities
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/app/models"
 	"github.com/chains-lab/voting-svc/internal/dbx"
 	"github.com/google/uuid"
 )
@@ -20,14 +26,23 @@ type petitionsQ interface {
 	FilterCityID(cityID uuid.UUID) dbx.PetitionsQ
 	FilterInitiatorID(initiatorID uuid.UUID) dbx.PetitionsQ
 	FilterStatus(status string) dbx.PetitionsQ
+	FilterEndDateBefore(t time.Time) dbx.PetitionsQ
+	FilterGoalReached(reached bool) dbx.PetitionsQ
+	ForUpdate() dbx.PetitionsQ
 
 	TitleLike(s string) dbx.PetitionsQ
+	Search(text string, configs ...string) dbx.PetitionsQ
+
+	OrderByCreatedAsc() dbx.PetitionsQ
+	OrderByCreatedDesc() dbx.PetitionsQ
+	OrderByRank() dbx.PetitionsQ
 
 	BBox(minLng, minLat, maxLng, maxLat float64) dbx.PetitionsQ
 	WithinRadius(lng, lat, radiusMeters float64) dbx.PetitionsQ
 
 	Count(ctx context.Context) (uint64, error)
-	Page(limit, offset uint64) dbx.PetitionsQ
+	Page(limit uint64, after *dbx.Cursor) (dbx.PetitionsQ, error)
+	Cursor(r dbx.Petition) dbx.Cursor
 }
 
 type signaturesQ interface {
@@ -43,5 +58,514 @@ type signaturesQ interface {
 	FilterUserID(userID uuid.UUID) dbx.PetitionSignaturesQ
 
 	Count(ctx context.Context) (uint64, error)
-	Page(limit, offset uint64) dbx.PetitionSignaturesQ
+	Page(limit uint64, after *dbx.Cursor) (dbx.PetitionSignaturesQ, error)
+	Cursor(r dbx.PetitionSignature) dbx.Cursor
+}
+
+var petitionTransitions = transitions{
+	models.PetitionStatusProcessed: {
+		models.PetitionStatusPublished: partyModerator,
+		models.PetitionStatusDeclined:  partyModerator,
+		models.PetitionStatusWithdrawn: partyInitiator | partyModerator,
+	},
+	models.PetitionStatusPublished: {
+		models.PetitionStatusWithdrawn: partyInitiator | partyModerator,
+		models.PetitionStatusApproved:  partyAddressee,
+		models.PetitionStatusRejected:  partyAddressee,
+	},
+	// в awaiting_response петицию переводит только система: триггер подписей или обработчик истечения срока
+	models.PetitionStatusAwaitingResponse: {
+		models.PetitionStatusApproved: partyAddressee,
+		models.PetitionStatusRejected: partyAddressee,
+	},
+}
+
+type Petitions struct {
+	db         *sql.DB
+	queries    petitionsQ
+	signatures signaturesQ
+	moderation moderationLogQ
+}
+
+func NewPetitions(db *sql.DB) Petitions {
+	return Petitions{
+		db:         db,
+		queries:    dbx.NewPetitionsQ(db),
+		signatures: dbx.NewPetitionSignaturesQ(db),
+		moderation: dbx.NewModerationLogQ(db),
+	}
+}
+
+func (p Petitions) Get(ctx context.Context, id uuid.UUID) (models.Petition, error) {
+	return p.get(ctx, id, p.queries.New().FilterID(id))
+}
+
+// CreatePetitionInput is a new petition as submitted by its initiator.
+type CreatePetitionInput struct {
+	Draft
+	AddressToID *uuid.UUID // nil addresses the city government
+	Goal        int
+	StopAtGoal  bool
+}
+
+// Create submits a petition on behalf of the actor. It stays processed until a moderator publishes it.
+func (p Petitions) Create(ctx context.Context, actor Actor, in CreatePetitionInput) (models.Petition, error) {
+	now := time.Now().UTC()
+	if err := in.validate(now); err != nil {
+		return models.Petition{}, err
+	}
+	if in.Goal < 0 {
+		return models.Petition{}, ape.InvalidField("goal", "must not be negative")
+	}
+
+	id := uuid.New()
+	err := p.queries.New().Insert(ctx, dbx.InsertPetitionInput{
+		ID:          id,
+		CityID:      in.CityID,
+		Title:       in.Title,
+		Description: in.Description,
+		InitiatorID: actor.UserID,
+		AddressToID: in.AddressToID,
+		Status:      models.PetitionStatusProcessed,
+		Goal:        in.Goal,
+		StopAtGoal:  in.StopAtGoal,
+		EndDate:     in.EndDate.UTC(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Location:    geoPoint(in.Location),
+	})
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	return p.Get(ctx, id)
+}
+
+// List returns a page of petitions matching the filter, newest first (most relevant first when searching),
+// and the total number of matches.
+func (p Petitions) List(ctx context.Context, filter ListFilter, page PageRequest) ([]models.Petition, uint64, string, error) {
+	if err := filter.validate(); err != nil {
+		return nil, 0, "", err
+	}
+
+	q := p.filter(filter)
+	if filter.Query != "" {
+		q = q.OrderByRank()
+	} else {
+		q = q.OrderByCreatedDesc()
+	}
+	return p.list(ctx, q, page)
+}
+
+// Queue returns the petitions of the city waiting for moderation, oldest first.
+func (p Petitions) Queue(ctx context.Context, actor Actor, cityID uuid.UUID, page PageRequest) ([]models.Petition, uint64, string, error) {
+	if err := checkModerator(actor, "petition", cityID); err != nil {
+		return nil, 0, "", err
+	}
+
+	q := p.filter(ListFilter{CityID: &cityID, Status: models.PetitionStatusProcessed})
+	return p.list(ctx, q.OrderByCreatedAsc(), page)
+}
+
+func (p Petitions) filter(filter ListFilter) dbx.PetitionsQ {
+	q := p.queries.New()
+	if filter.CityID != nil {
+		q = q.FilterCityID(*filter.CityID)
+	}
+	if filter.InitiatorID != nil {
+		q = q.FilterInitiatorID(*filter.InitiatorID)
+	}
+	if filter.Status != "" {
+		q = q.FilterStatus(filter.Status)
+	}
+	if filter.Title != "" {
+		q = q.TitleLike(filter.Title)
+	}
+	if filter.Query != "" {
+		q = q.Search(filter.Query, filter.searchConfigs()...)
+	}
+	if b := filter.BBox; b != nil {
+		q = q.BBox(b.MinLng, b.MinLat, b.MaxLng, b.MaxLat)
+	}
+	return q
+}
+
+func (p Petitions) list(ctx context.Context, q dbx.PetitionsQ, page PageRequest) ([]models.Petition, uint64, string, error) {
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	q, err = applyPage(q, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, err := q.Select(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, next := nextPage(rows, page, q.Cursor)
+
+	petitions := make([]models.Petition, 0, len(rows))
+	for _, row := range rows {
+		petitions = append(petitions, petitionModel(row))
+	}
+	return petitions, total, next, nil
+}
+
+// lock reads the petition with FOR UPDATE; must be called inside dbx.Transaction.
+func (p Petitions) lock(ctx context.Context, id uuid.UUID) (models.Petition, error) {
+	return p.get(ctx, id, p.queries.New().FilterID(id).ForUpdate())
+}
+
+func (p Petitions) get(ctx context.Context, id uuid.UUID, q dbx.PetitionsQ) (models.Petition, error) {
+	petition, err := q.Get(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Petition{}, fmt.Errorf("petition %s: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return models.Petition{}, err
+	}
+	return petitionModel(petition), nil
+}
+
+// AvailableStatuses returns the statuses the actor may move the petition to.
+func (p Petitions) AvailableStatuses(actor Actor, petition models.Petition) []string {
+	return petitionTransitions.available(petition.Status, petitionParties(actor, petition))
+}
+
+// ChangeStatus moves the petition to the given status if the transition is legal and the actor may perform it.
+func (p Petitions) ChangeStatus(ctx context.Context, actor Actor, id uuid.UUID, status string) (models.Petition, error) {
+	var updated models.Petition
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		petition, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = petitionTransitions.check("petition", petition.Status, status, petitionParties(actor, petition))
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		err = p.queries.New().FilterID(id).Update(ctx, dbx.UpdatePetitionInput{
+			Status:    &status,
+			UpdatedAt: &now,
+		})
+		if err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	return updated, nil
+}
+
+// CloseExpired ends collection for every published petition whose end_date has passed: petitions that reached
+// their goal go to awaiting_response, the rest expire. Both steps are conditional UPDATEs, so concurrent calls
+// from several replicas are harmless.
+func (p Petitions) CloseExpired(ctx context.Context, now time.Time) error {
+	awaiting := models.PetitionStatusAwaitingResponse
+	err := p.queries.New().
+		FilterStatus(models.PetitionStatusPublished).
+		FilterEndDateBefore(now).
+		FilterGoalReached(true).
+		Update(ctx, dbx.UpdatePetitionInput{Status: &awaiting, UpdatedAt: &now})
+	if err != nil {
+		return err
+	}
+
+	expired := models.PetitionStatusExpired
+	return p.queries.New().
+		FilterStatus(models.PetitionStatusPublished).
+		FilterEndDateBefore(now).
+		Update(ctx, dbx.UpdatePetitionInput{Status: &expired, UpdatedAt: &now})
+}
+
+// Sign adds the actor's signature and returns the petition as it is after signing. Reaching the goal is
+// detected by the signatures trigger: it stamps GoalReachedAt and, if StopAtGoal is set, moves the petition
+// to awaiting_response so no further signatures are accepted.
+func (p Petitions) Sign(ctx context.Context, actor Actor, petitionID uuid.UUID) (models.Petition, error) {
+	var petition models.Petition
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		if err := p.checkCollecting(ctx, petitionID); err != nil {
+			return err
+		}
+
+		_, err := p.signatures.New().FilterPetitionID(petitionID).FilterUserID(actor.UserID).Get(ctx)
+		if err == nil {
+			return fmt.Errorf("petition %s: %w", petitionID, ErrAlreadySigned)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		err = p.signatures.New().Insert(ctx, dbx.PetitionSignature{
+			ID:         uuid.New(),
+			PetitionID: petitionID,
+			UserID:     actor.UserID,
+			CreatedAt:  time.Now().UTC(),
+		})
+		// параллельный запрос того же пользователя успел подписать между проверкой и вставкой
+		if dbx.IsUniqueViolation(err, dbx.PetitionSignatureUserKey) {
+			return fmt.Errorf("petition %s: %w", petitionID, ErrAlreadySigned)
+		}
+		if err != nil {
+			return err
+		}
+
+		petition, err = p.Get(ctx, petitionID)
+		return err
+	})
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	return petition, nil
+}
+
+// Unsign removes the actor's signature while the petition is still collecting.
+func (p Petitions) Unsign(ctx context.Context, actor Actor, petitionID uuid.UUID) (models.Petition, error) {
+	var petition models.Petition
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		if err := p.checkCollecting(ctx, petitionID); err != nil {
+			return err
+		}
+
+		_, err := p.signatures.New().FilterPetitionID(petitionID).FilterUserID(actor.UserID).Get(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("signature on petition %s: %w", petitionID, ErrNotFound)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = p.signatures.New().FilterPetitionID(petitionID).FilterUserID(actor.UserID).Delete(ctx)
+		if err != nil {
+			return err
+		}
+
+		petition, err = p.Get(ctx, petitionID)
+		return err
+	})
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	return petition, nil
+}
+
+// Signature returns the actor's signature on the petition.
+func (p Petitions) Signature(ctx context.Context, actor Actor, petitionID uuid.UUID) (models.PetitionSignature, error) {
+	s, err := p.signatures.New().FilterPetitionID(petitionID).FilterUserID(actor.UserID).Get(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.PetitionSignature{}, fmt.Errorf("signature on petition %s: %w", petitionID, ErrNotFound)
+	}
+	if err != nil {
+		return models.PetitionSignature{}, err
+	}
+
+	return models.PetitionSignature{
+		ID:         s.ID,
+		PetitionID: s.PetitionID,
+		UserID:     s.UserID,
+		CreatedAt:  s.CreatedAt,
+	}, nil
+}
+
+// checkCollecting — подписи принимаются только у опубликованной петиции до end_date.
+// Строка петиции блокируется, чтобы смена статуса не прошла между проверкой и подписью.
+func (p Petitions) checkCollecting(ctx context.Context, petitionID uuid.UUID) error {
+	petition, err := p.lock(ctx, petitionID)
+	if err != nil {
+		return err
+	}
+	if petition.Status != models.PetitionStatusPublished {
+		return fmt.Errorf("petition %s is %s: %w", petitionID, petition.Status, ErrVotingClosed)
+	}
+	if !time.Now().UTC().Before(petition.EndDate) {
+		return fmt.Errorf("petition %s ended at %s: %w", petitionID, petition.EndDate, ErrVotingClosed)
+	}
+	return nil
+}
+
+// Moderate moves the petition to published, declined or withdrawn on behalf of a moderator of its city
+// and records the action with its reason in the moderation log.
+func (p Petitions) Moderate(ctx context.Context, actor Actor, id uuid.UUID, status, reason string) (models.Petition, error) {
+	action, err := moderationAction(status, reason)
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	var updated models.Petition
+	err = dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		petition, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "petition", petition.CityID); err != nil {
+			return err
+		}
+
+		if updated, err = p.ChangeStatus(ctx, actor, id, status); err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityPetition, id, petition.CityID, action, reason, nil)
+		return p.moderation.New().Insert(ctx, entry)
+	})
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	return updated, nil
+}
+
+// Edit lets a moderator of the petition's city correct its title or description.
+func (p Petitions) Edit(ctx context.Context, actor Actor, id uuid.UUID, edit ContentEdit, reason string) (models.Petition, error) {
+	if err := edit.validate(); err != nil {
+		return models.Petition{}, err
+	}
+	if err := checkReason(reason); err != nil {
+		return models.Petition{}, err
+	}
+
+	var updated models.Petition
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		petition, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "petition", petition.CityID); err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		err = p.queries.New().FilterID(id).Update(ctx, dbx.UpdatePetitionInput{
+			Title:       edit.Title,
+			Description: edit.Description,
+			UpdatedAt:   &now,
+		})
+		if err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityPetition, id, petition.CityID, models.ModerationEdit, reason, nil)
+		if err = p.moderation.New().Insert(ctx, entry); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	return updated, nil
+}
+
+// RemoveSignature deletes an abusive signature of the given user; the counter follows through the trigger.
+func (p Petitions) RemoveSignature(ctx context.Context, actor Actor, petitionID, userID uuid.UUID, reason string) (models.Petition, error) {
+	if err := checkReason(reason); err != nil {
+		return models.Petition{}, err
+	}
+
+	var updated models.Petition
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		petition, err := p.lock(ctx, petitionID)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "petition", petition.CityID); err != nil {
+			return err
+		}
+
+		_, err = p.signatures.New().FilterPetitionID(petitionID).FilterUserID(userID).Get(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("signature of user %s on petition %s: %w", userID, petitionID, ErrNotFound)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = p.signatures.New().FilterPetitionID(petitionID).FilterUserID(userID).Delete(ctx); err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityPetition, petitionID, petition.CityID, models.ModerationDeleteSignature, reason, &userID)
+		if err = p.moderation.New().Insert(ctx, entry); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, petitionID)
+		return err
+	})
+	if err != nil {
+		return models.Petition{}, err
+	}
+
+	return updated, nil
+}
+
+func (p Petitions) Publish(ctx context.Context, actor Actor, id uuid.UUID) (models.Petition, error) {
+	return p.ChangeStatus(ctx, actor, id, models.PetitionStatusPublished)
+}
+
+func (p Petitions) Decline(ctx context.Context, actor Actor, id uuid.UUID) (models.Petition, error) {
+	return p.ChangeStatus(ctx, actor, id, models.PetitionStatusDeclined)
+}
+
+func (p Petitions) Withdraw(ctx context.Context, actor Actor, id uuid.UUID) (models.Petition, error) {
+	return p.ChangeStatus(ctx, actor, id, models.PetitionStatusWithdrawn)
+}
+
+func (p Petitions) Approve(ctx context.Context, actor Actor, id uuid.UUID) (models.Petition, error) {
+	return p.ChangeStatus(ctx, actor, id, models.PetitionStatusApproved)
+}
+
+func (p Petitions) Reject(ctx context.Context, actor Actor, id uuid.UUID) (models.Petition, error) {
+	return p.ChangeStatus(ctx, actor, id, models.PetitionStatusRejected)
+}
+
+func petitionParties(actor Actor, petition models.Petition) party {
+	var who party
+	if actor.UserID == petition.InitiatorID {
+		who |= partyInitiator
+	}
+	if actor.ModeratorOf(petition.CityID) {
+		who |= partyModerator
+	}
+	if actor.AddresseeOf(petition.CityID, petition.AddressToID) {
+		who |= partyAddressee
+	}
+	return who
+}
+
+func petitionModel(p dbx.Petition) models.Petition {
+	return models.Petition{
+		ID:            p.ID,
+		CityID:        p.CityID,
+		Title:         p.Title,
+		Description:   p.Description,
+		InitiatorID:   p.InitiatorID,
+		AddressToID:   p.AddressToID,
+		Status:        p.Status,
+		Signatures:    p.Signatures,
+		Goal:          p.Goal,
+		StopAtGoal:    p.StopAtGoal,
+		GoalReachedAt: p.GoalReachedAt,
+		EndDate:       p.EndDate,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+		Location:      locationModel(p.Lat, p.Lng),
+		Rank:          p.Rank,
+		Snippet:       p.Snippet,
+	}
 }
@@ -2,7 +2,18 @@ package entities
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/app/mixer"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/app/tally"
 	"github.com/chains-lab/voting-svc/internal/dbx"
 	"github.com/google/uuid"
 )
@@ -11,6 +22,7 @@ type pollsQ interface {
 	New() dbx.PollsQ
 
 	Insert(ctx context.Context, in dbx.InsertPollInput) error
+	InsertWithOptions(ctx context.Context, in dbx.InsertPollInput, options []dbx.InsertPollOptionInput) error
 	Update(ctx context.Context, in dbx.UpdatePollInput) error
 	Delete(ctx context.Context) error
 	Get(ctx context.Context) (dbx.Poll, error)
@@ -20,32 +32,48 @@ type pollsQ interface {
 	FilterCityID(cityID uuid.UUID) dbx.PollsQ
 	FilterInitiatorID(initiatorID uuid.UUID) dbx.PollsQ
 	FilterStatus(status string) dbx.PollsQ
+	FilterEndDateBefore(t time.Time) dbx.PollsQ
+	ForUpdate() dbx.PollsQ
 	TitleLike(s string) dbx.PollsQ
+	Search(text string, configs ...string) dbx.PollsQ
+
+	OrderByCreatedAsc() dbx.PollsQ
+	OrderByCreatedDesc() dbx.PollsQ
+	OrderByRank() dbx.PollsQ
 
 	BBox(minLng, minLat, maxLng, maxLat float64) dbx.PollsQ
 	WithinRadius(lng, lat, radiusMeters float64) dbx.PollsQ
 
 	Count(ctx context.Context) (uint64, error)
-	Page(limit, offset uint64) dbx.PollsQ
+	Page(limit uint64, after *dbx.Cursor) (dbx.PollsQ, error)
+	Cursor(r dbx.Poll) dbx.Cursor
 }
 
 type pollOptionsQ interface {
 	New() dbx.PollOptionsQ
 
 	Insert(ctx context.Context, in dbx.InsertPollOptionInput) error
+	Update(ctx context.Context, in dbx.UpdatePollOptionInput) error
 	Get(ctx context.Context) (dbx.PollOption, error)
 	Select(ctx context.Context) ([]dbx.PollOption, error)
 	Delete(ctx context.Context) error
 
 	FilterID(id uuid.UUID) dbx.PollOptionsQ
 	FilterPollID(pollID uuid.UUID) dbx.PollOptionsQ
+	FilterPollIDs(pollIDs []uuid.UUID) dbx.PollOptionsQ
 
+	OrderByPosition() dbx.PollOptionsQ
 	OrderByCreatedAsc() dbx.PollOptionsQ
 	OrderByCreatedDesc() dbx.PollOptionsQ
 	OrderByVotesDesc() dbx.PollOptionsQ
 
+	ApprovalCounts(ctx context.Context) ([]dbx.OptionApprovals, error)
+	ScoreStats(ctx context.Context) ([]dbx.OptionScoreStats, error)
+	ScoreDistribution(ctx context.Context) ([]dbx.OptionScoreCount, error)
+
 	Count(ctx context.Context) (uint64, error)
-	Page(limit, offset uint64) dbx.PollOptionsQ
+	Page(limit uint64, after *dbx.Cursor) (dbx.PollOptionsQ, error)
+	Cursor(r dbx.PollOption) dbx.Cursor
 }
 
 type pollVotesQ interface {
@@ -56,6 +84,8 @@ type pollVotesQ interface {
 	Select(ctx context.Context) ([]dbx.PollVote, error)
 	Update(ctx context.Context, in dbx.UpdatePollVoteInput) error
 	Delete(ctx context.Context) error
+	InsertBallot(ctx context.Context, pollID uuid.UUID, userID *uuid.UUID, choices []dbx.PollChoice, createdAt time.Time) error
+	ReplaceSelection(ctx context.Context, pollID, userID uuid.UUID, choices []dbx.PollChoice, createdAt time.Time) error
 
 	FilterID(id uuid.UUID) dbx.PollVotesQ
 	FilterPollID(pollID uuid.UUID) dbx.PollVotesQ
@@ -64,7 +94,933 @@ type pollVotesQ interface {
 
 	OrderByCreatedAsc() dbx.PollVotesQ
 	OrderByCreatedDesc() dbx.PollVotesQ
+	OrderByBallot() dbx.PollVotesQ
+
+	Count(ctx context.Context) (uint64, error)
+	Page(limit uint64, after *dbx.Cursor) (dbx.PollVotesQ, error)
+	Cursor(r dbx.PollVote) dbx.Cursor
+}
+
+type pollParticipantsQ interface {
+	New() dbx.PollParticipantsQ
+
+	Insert(ctx context.Context, in dbx.PollParticipant) error
+	Get(ctx context.Context) (dbx.PollParticipant, error)
+	Select(ctx context.Context) ([]dbx.PollParticipant, error)
+	Delete(ctx context.Context) error
+
+	FilterPollID(pollID uuid.UUID) dbx.PollParticipantsQ
+	FilterUserID(userID uuid.UUID) dbx.PollParticipantsQ
 
 	Count(ctx context.Context) (uint64, error)
-	Page(limit, offset uint64) dbx.PollVotesQ
+	Page(limit uint64, after *dbx.Cursor) (dbx.PollParticipantsQ, error)
+	Cursor(r dbx.PollParticipant) dbx.Cursor
+}
+
+var pollTransitions = transitions{
+	models.PollStatusProcessed: {
+		models.PollStatusPublished: partyModerator,
+		models.PollStatusDeclined:  partyModerator,
+		models.PollStatusWithdrawn: partyInitiator | partyModerator,
+	},
+	models.PollStatusPublished: {
+		models.PollStatusWithdrawn: partyInitiator | partyModerator,
+	},
+}
+
+type Polls struct {
+	db           *sql.DB
+	queries      pollsQ
+	options      pollOptionsQ
+	votes        pollVotesQ
+	participants pollParticipantsQ
+	moderation   moderationLogQ
+
+	secretBallots *mixer.Mixer[dbx.AnonymousBallot] // см. Vote
+}
+
+// Батчи тайных бюллетеней: чем длиннее окно, тем больше бюллетеней в батче и тем труднее
+// сопоставить голосующего с бюллетенем, но тем дольше ждёт голос.
+const (
+	secretBatchSize  = 32
+	secretBatchDelay = 2 * time.Second
+
+	// participationCleanupTimeout — на удаление участия, если батч с бюллетенем не записался.
+	participationCleanupTimeout = 5 * time.Second
+)
+
+func NewPolls(db *sql.DB) Polls {
+	return Polls{
+		db:      db,
+		queries: dbx.NewPollsQ(db),
+		options: dbx.NewPollOptionsQ(db),
+		votes:   dbx.NewPollVotesQ(db),
+
+		participants: dbx.NewPollParticipantsQ(db),
+		moderation:   dbx.NewModerationLogQ(db),
+
+		secretBallots: mixer.New(func(ctx context.Context, batch []dbx.AnonymousBallot) error {
+			return dbx.NewPollVotesQ(db).InsertAnonymous(ctx, batch, time.Now().UTC().Truncate(24*time.Hour))
+		}, secretBatchSize, secretBatchDelay),
+	}
+}
+
+// Get returns the poll with its options.
+func (p Polls) Get(ctx context.Context, id uuid.UUID) (models.Poll, error) {
+	return p.get(ctx, id, p.queries.New().FilterID(id))
+}
+
+// CreatePollInput is a new poll as submitted by its initiator.
+type CreatePollInput struct {
+	Draft
+	Type       string // models.PollType*; empty means choice
+	Secret     bool
+	MinChoices int // choice and ranked polls only; 0 picks the default of the type
+	MaxChoices int
+	MaxScore   int // score polls only
+	Options    []string
+}
+
+// Create submits a poll with its options on behalf of the actor. It stays processed until a moderator publishes it.
+func (p Polls) Create(ctx context.Context, actor Actor, in CreatePollInput) (models.Poll, error) {
+	now := time.Now().UTC()
+	if err := in.validate(now); err != nil {
+		return models.Poll{}, err
+	}
+	if err := normalizePollSettings(&in); err != nil {
+		return models.Poll{}, err
+	}
+
+	id := uuid.New()
+	options := make([]dbx.InsertPollOptionInput, 0, len(in.Options))
+	for i, text := range in.Options {
+		options = append(options, dbx.InsertPollOptionInput{
+			ID:         uuid.New(),
+			PollID:     id,
+			OptionText: text,
+			Position:   i,
+			CreatedAt:  now,
+		})
+	}
+
+	err := p.queries.New().InsertWithOptions(ctx, dbx.InsertPollInput{
+		ID:          id,
+		CityID:      in.CityID,
+		Title:       in.Title,
+		Description: in.Description,
+		Status:      models.PollStatusProcessed,
+		Type:        in.Type,
+		Secret:      in.Secret,
+		InitiatorID: actor.UserID,
+		MinChoices:  in.MinChoices,
+		MaxChoices:  in.MaxChoices,
+		MaxScore:    in.MaxScore,
+		EndDate:     in.EndDate.UTC(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Location:    geoPoint(in.Location),
+	}, options)
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return p.Get(ctx, id)
+}
+
+// normalizeOptions обрезает пробелы в текстах вариантов и проверяет их число и уникальность
+// (без учёта регистра — так же, как индекс poll_options_text_uniq).
+func normalizeOptions(options []string) error {
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return ape.InvalidField("options", "poll needs %d..%d options, got %d", minPollOptions, maxPollOptions, len(options))
+	}
+
+	seen := make(map[string]int, len(options))
+	for i, text := range options {
+		text, err := optionText(fmt.Sprintf("options[%d]", i), text)
+		if err != nil {
+			return err
+		}
+		key := strings.ToLower(text)
+		if j, ok := seen[key]; ok {
+			return ape.InvalidField(fmt.Sprintf("options[%d]", i), "duplicates options[%d]", j)
+		}
+		seen[key] = i
+		options[i] = text
+	}
+	return nil
+}
+
+// optionText обрезает пробелы в тексте варианта и проверяет его длину.
+func optionText(field, text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxOptionLen {
+		return "", ape.InvalidField(field, "option text must be 1..%d characters", maxOptionLen)
+	}
+	return text, nil
+}
+
+// normalizePollSettings подставляет значения по умолчанию для типа опроса и проверяет лимиты выбора.
+// Для approval и score лимиты не используются: их задаёт число вариантов.
+func normalizePollSettings(in *CreatePollInput) error {
+	if in.Type == "" {
+		in.Type = models.PollTypeChoice
+	}
+	if err := normalizeOptions(in.Options); err != nil {
+		return err
+	}
+
+	switch in.Type {
+	case models.PollTypeChoice, models.PollTypeRanked:
+		if in.MinChoices == 0 {
+			in.MinChoices = 1
+		}
+		if in.MaxChoices == 0 {
+			in.MaxChoices = 1
+			if in.Type == models.PollTypeRanked {
+				in.MaxChoices = len(in.Options)
+			}
+		}
+		if in.MinChoices < 1 || in.MaxChoices < in.MinChoices || in.MaxChoices > len(in.Options) {
+			return ape.InvalidField("max_choices", "selection limits %d..%d do not fit %d options",
+				in.MinChoices, in.MaxChoices, len(in.Options))
+		}
+		if in.MaxScore != 0 {
+			return ape.InvalidField("max_score", "%s poll does not take a max score", in.Type)
+		}
+	case models.PollTypeApproval, models.PollTypeScore:
+		if in.MinChoices != 0 || in.MaxChoices != 0 {
+			return ape.InvalidField("max_choices", "%s poll does not take selection limits", in.Type)
+		}
+		in.MinChoices, in.MaxChoices = 1, len(in.Options)
+		if in.Type == models.PollTypeScore && in.MaxScore < 1 {
+			return ape.InvalidField("max_score", "score poll needs a positive max score")
+		}
+		if in.Type == models.PollTypeApproval && in.MaxScore != 0 {
+			return ape.InvalidField("max_score", "approval poll does not take a max score")
+		}
+	default:
+		return ape.InvalidField("type", "unknown poll type %q", in.Type)
+	}
+	return nil
+}
+
+// List returns a page of polls matching the filter, newest first (most relevant first when searching),
+// and the total number of matches.
+func (p Polls) List(ctx context.Context, filter ListFilter, page PageRequest) ([]models.Poll, uint64, string, error) {
+	if err := filter.validate(); err != nil {
+		return nil, 0, "", err
+	}
+
+	q := p.filter(filter)
+	if filter.Query != "" {
+		q = q.OrderByRank()
+	} else {
+		q = q.OrderByCreatedDesc()
+	}
+	return p.list(ctx, q, page)
+}
+
+// Queue returns the polls of the city waiting for moderation, oldest first.
+func (p Polls) Queue(ctx context.Context, actor Actor, cityID uuid.UUID, page PageRequest) ([]models.Poll, uint64, string, error) {
+	if err := checkModerator(actor, "poll", cityID); err != nil {
+		return nil, 0, "", err
+	}
+
+	q := p.filter(ListFilter{CityID: &cityID, Status: models.PollStatusProcessed})
+	return p.list(ctx, q.OrderByCreatedAsc(), page)
+}
+
+func (p Polls) filter(filter ListFilter) dbx.PollsQ {
+	q := p.queries.New()
+	if filter.CityID != nil {
+		q = q.FilterCityID(*filter.CityID)
+	}
+	if filter.InitiatorID != nil {
+		q = q.FilterInitiatorID(*filter.InitiatorID)
+	}
+	if filter.Status != "" {
+		q = q.FilterStatus(filter.Status)
+	}
+	if filter.Title != "" {
+		q = q.TitleLike(filter.Title)
+	}
+	if filter.Query != "" {
+		q = q.Search(filter.Query, filter.searchConfigs()...)
+	}
+	if b := filter.BBox; b != nil {
+		q = q.BBox(b.MinLng, b.MinLat, b.MaxLng, b.MaxLat)
+	}
+	return q
+}
+
+func (p Polls) list(ctx context.Context, q dbx.PollsQ, page PageRequest) ([]models.Poll, uint64, string, error) {
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	q, err = applyPage(q, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, err := q.Select(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, next := nextPage(rows, page, q.Cursor)
+	if len(rows) == 0 {
+		return []models.Poll{}, total, "", nil
+	}
+
+	// варианты всей страницы одним запросом
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	options, err := p.options.New().FilterPollIDs(ids).OrderByPosition().Select(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	byPoll := make(map[uuid.UUID][]dbx.PollOption, len(rows))
+	for _, o := range options {
+		byPoll[o.PollID] = append(byPoll[o.PollID], o)
+	}
+
+	polls := make([]models.Poll, 0, len(rows))
+	for _, row := range rows {
+		polls = append(polls, pollModel(row, byPoll[row.ID]))
+	}
+	return polls, total, next, nil
+}
+
+// lock reads the poll with FOR UPDATE; must be called inside dbx.Transaction.
+func (p Polls) lock(ctx context.Context, id uuid.UUID) (models.Poll, error) {
+	return p.get(ctx, id, p.queries.New().FilterID(id).ForUpdate())
+}
+
+func (p Polls) get(ctx context.Context, id uuid.UUID, q dbx.PollsQ) (models.Poll, error) {
+	poll, err := q.Get(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Poll{}, fmt.Errorf("poll %s: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	options, err := p.options.New().FilterPollID(id).OrderByPosition().Select(ctx)
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return pollModel(poll, options), nil
+}
+
+// AvailableStatuses returns the statuses the actor may move the poll to.
+func (p Polls) AvailableStatuses(actor Actor, poll models.Poll) []string {
+	return pollTransitions.available(poll.Status, pollParties(actor, poll))
+}
+
+// ChangeStatus moves the poll to the given status if the transition is legal and the actor may perform it.
+func (p Polls) ChangeStatus(ctx context.Context, actor Actor, id uuid.UUID, status string) (models.Poll, error) {
+	var updated models.Poll
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		poll, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err = pollTransitions.check("poll", poll.Status, status, pollParties(actor, poll)); err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		err = p.queries.New().FilterID(id).Update(ctx, dbx.UpdatePollInput{
+			Status:    &status,
+			UpdatedAt: &now,
+		})
+		if err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return updated, nil
+}
+
+// Moderate moves the poll to published, declined or withdrawn on behalf of a moderator of its city
+// and records the action with its reason in the moderation log.
+func (p Polls) Moderate(ctx context.Context, actor Actor, id uuid.UUID, status, reason string) (models.Poll, error) {
+	action, err := moderationAction(status, reason)
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	var updated models.Poll
+	err = dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		poll, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "poll", poll.CityID); err != nil {
+			return err
+		}
+
+		if updated, err = p.ChangeStatus(ctx, actor, id, status); err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityPoll, id, poll.CityID, action, reason, nil)
+		return p.moderation.New().Insert(ctx, entry)
+	})
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return updated, nil
+}
+
+// Edit lets a moderator of the poll's city correct its title or description.
+func (p Polls) Edit(ctx context.Context, actor Actor, id uuid.UUID, edit ContentEdit, reason string) (models.Poll, error) {
+	if err := edit.validate(); err != nil {
+		return models.Poll{}, err
+	}
+	if err := checkReason(reason); err != nil {
+		return models.Poll{}, err
+	}
+
+	var updated models.Poll
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		poll, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "poll", poll.CityID); err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		err = p.queries.New().FilterID(id).Update(ctx, dbx.UpdatePollInput{
+			Title:       edit.Title,
+			Description: edit.Description,
+			UpdatedAt:   &now,
+		})
+		if err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityPoll, id, poll.CityID, models.ModerationEdit, reason, nil)
+		if err = p.moderation.New().Insert(ctx, entry); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return updated, nil
+}
+
+// RemoveVotes deletes the abusive ballot of the given user; the counters follow through the trigger.
+// Ballots of secret polls are not linked to voters and cannot be removed.
+func (p Polls) RemoveVotes(ctx context.Context, actor Actor, pollID, userID uuid.UUID, reason string) (models.Poll, error) {
+	if err := checkReason(reason); err != nil {
+		return models.Poll{}, err
+	}
+
+	var updated models.Poll
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		poll, err := p.lock(ctx, pollID)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "poll", poll.CityID); err != nil {
+			return err
+		}
+		if poll.Secret {
+			return fmt.Errorf("%w: ballots of secret poll %s are not linked to voters", ErrInvalidVote, pollID)
+		}
+
+		count, err := p.votes.New().FilterPollID(pollID).FilterUserID(userID).Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("vote of user %s on poll %s: %w", userID, pollID, ErrNotFound)
+		}
+
+		if err = p.votes.New().ReplaceSelection(ctx, pollID, userID, nil, time.Now().UTC()); err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityPoll, pollID, poll.CityID, models.ModerationDeleteVote, reason, &userID)
+		if err = p.moderation.New().Insert(ctx, entry); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, pollID)
+		return err
+	})
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return updated, nil
+}
+
+// Vote replaces the actor's whole ballot in the poll. What a valid ballot is depends on the poll type:
+//   - choice: between MinChoices and MaxChoices distinct options;
+//   - ranked: the same, in order of preference, the most preferred first;
+//   - approval: any non-empty set of distinct options;
+//   - score: every option exactly once, each with a score in 0..MaxScore.
+//
+// In secret polls the ballot is final and stored without the voter, so no votes are returned.
+// The participation is committed first; the ballot is written afterwards by the ballot mixer,
+// in another transaction, together with the other secret ballots of its batch in random order and
+// with created_at truncated to the day. Nothing stored links the two rows: someone reading the database
+// can only tell which batch a voter's ballot is in, by comparing the participation time with the time the
+// batch was committed. A batch holds the ballots cast within secretBatchDelay of each other, up to
+// secretBatchSize, so the guarantee is only as strong as the voting traffic: a ballot that was alone
+// in its window is not hidden. The call then waits up to secretBatchDelay for its batch.
+// If the process dies after the participation is committed but before the batch is written, the ballot is lost
+// and the voter cannot vote again.
+//
+// Secret ballots cannot be cast inside dbx.Transaction: the participation must be committed before the
+// ballot is queued.
+func (p Polls) Vote(ctx context.Context, actor Actor, pollID uuid.UUID, choices []models.PollChoice) ([]models.PollVote, error) {
+	_, outerTx := ctx.Value(dbx.TxKey).(*sql.Tx)
+
+	var (
+		votes  []models.PollVote
+		secret *dbx.AnonymousBallot
+	)
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		secret = nil
+
+		poll, err := p.checkVotingOpen(ctx, pollID)
+		if err != nil {
+			return err
+		}
+
+		if err = validateBallot(poll, choices); err != nil {
+			return err
+		}
+
+		if poll.Secret {
+			if outerTx {
+				return fmt.Errorf("secret ballot of poll %s cast inside a transaction", pollID)
+			}
+			ballot, err := p.registerParticipant(ctx, actor, poll, choices)
+			secret = &ballot
+			return err
+		}
+
+		err = p.votes.New().ReplaceSelection(ctx, pollID, actor.UserID, pollChoices(poll, choices), time.Now().UTC())
+		// параллельный голос того же пользователя записался первым
+		if dbx.IsUniqueViolation(err, dbx.PollVoteOptionKey) {
+			return fmt.Errorf("poll %s: %w", pollID, ErrAlreadyVoted)
+		}
+		if err != nil {
+			return err
+		}
+
+		votes, err = p.UserVotes(ctx, actor, pollID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if secret != nil {
+		return nil, p.castSecretBallot(ctx, actor, *secret)
+	}
+	return votes, nil
+}
+
+// registerParticipant записывает факт участия в тайном опросе и возвращает бюллетень без голосующего.
+// Сам бюллетень в этой транзакции не пишется.
+func (p Polls) registerParticipant(ctx context.Context, actor Actor, poll models.Poll, choices []models.PollChoice) (dbx.AnonymousBallot, error) {
+	_, err := p.participants.New().FilterPollID(poll.ID).FilterUserID(actor.UserID).Get(ctx)
+	if err == nil {
+		return dbx.AnonymousBallot{}, fmt.Errorf("poll %s: %w", poll.ID, ErrAlreadyVoted)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return dbx.AnonymousBallot{}, err
+	}
+
+	err = p.participants.New().Insert(ctx, dbx.PollParticipant{
+		ID:        uuid.New(),
+		PollID:    poll.ID,
+		UserID:    actor.UserID,
+		CreatedAt: time.Now().UTC(),
+	})
+	if dbx.IsUniqueViolation(err, dbx.PollParticipantUserKey) {
+		return dbx.AnonymousBallot{}, fmt.Errorf("poll %s: %w", poll.ID, ErrAlreadyVoted)
+	}
+	if err != nil {
+		return dbx.AnonymousBallot{}, err
+	}
+
+	return dbx.AnonymousBallot{PollID: poll.ID, Choices: pollChoices(poll, choices)}, nil
+}
+
+// castSecretBallot отдаёт бюллетень миксеру и ждёт записи его батча. Если батч не записался,
+// факт участия удаляется, чтобы голосующий мог повторить попытку. Submit может ждать дольше,
+// чем живёт запрос, поэтому удаление не зависит от его отмены и ограничено своим таймаутом:
+// оставшаяся строка участия без бюллетеня навсегда закрыла бы голосующему опрос.
+func (p Polls) castSecretBallot(ctx context.Context, actor Actor, ballot dbx.AnonymousBallot) error {
+	err := p.secretBallots.Submit(ballot)
+	if err == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), participationCleanupTimeout)
+	defer cancel()
+	if delErr := p.participants.New().FilterPollID(ballot.PollID).FilterUserID(actor.UserID).Delete(ctx); delErr != nil {
+		return errors.Join(err, fmt.Errorf("removing participation in poll %s: %w", ballot.PollID, delErr))
+	}
+	return err
+}
+
+// RetractVote removes the actor's whole selection while voting is still open.
+// Secret ballots cannot be retracted.
+func (p Polls) RetractVote(ctx context.Context, actor Actor, pollID uuid.UUID) error {
+	return dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		poll, err := p.checkVotingOpen(ctx, pollID)
+		if err != nil {
+			return err
+		}
+		if poll.Secret {
+			return fmt.Errorf("%w: secret ballots in poll %s are final", ErrInvalidVote, pollID)
+		}
+
+		count, err := p.votes.New().FilterPollID(pollID).FilterUserID(actor.UserID).Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("vote on poll %s: %w", pollID, ErrNotFound)
+		}
+
+		return p.votes.New().ReplaceSelection(ctx, pollID, actor.UserID, nil, time.Now().UTC())
+	})
+}
+
+// HasVoted reports whether the actor has cast a ballot in the poll, secret or not.
+func (p Polls) HasVoted(ctx context.Context, actor Actor, pollID uuid.UUID) (bool, error) {
+	poll, err := p.Get(ctx, pollID)
+	if err != nil {
+		return false, err
+	}
+
+	var count uint64
+	if poll.Secret {
+		count, err = p.participants.New().FilterPollID(pollID).FilterUserID(actor.UserID).Count(ctx)
+	} else {
+		count, err = p.votes.New().FilterPollID(pollID).FilterUserID(actor.UserID).Count(ctx)
+	}
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// UserVotes returns the options the actor currently has selected in the poll; always empty for secret polls.
+func (p Polls) UserVotes(ctx context.Context, actor Actor, pollID uuid.UUID) ([]models.PollVote, error) {
+	rows, err := p.votes.New().FilterPollID(pollID).FilterUserID(actor.UserID).OrderByBallot().Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]models.PollVote, 0, len(rows))
+	for _, v := range rows {
+		votes = append(votes, pollVoteModel(v))
+	}
+	return votes, nil
+}
+
+// Tally returns the current vote counters of the poll's options, most voted first.
+func (p Polls) Tally(ctx context.Context, pollID uuid.UUID) (models.PollTally, error) {
+	options, err := p.options.New().FilterPollID(pollID).OrderByVotesDesc().Select(ctx)
+	if err != nil {
+		return models.PollTally{}, err
+	}
+
+	tally := models.PollTally{
+		PollID:    pollID,
+		Options:   make([]models.PollOption, 0, len(options)),
+		UpdatedAt: time.Now().UTC(),
+	}
+	for _, o := range options {
+		tally.Options = append(tally.Options, pollOptionModel(o))
+	}
+	return tally, nil
+}
+
+// Results returns the options ordered by votes together with the type-specific report: approval counts,
+// score statistics, or the instant-runoff and Schulze reports for ranked polls.
+func (p Polls) Results(ctx context.Context, pollID uuid.UUID) (models.PollResults, error) {
+	poll, err := p.Get(ctx, pollID)
+	if err != nil {
+		return models.PollResults{}, err
+	}
+
+	options, err := p.options.New().FilterPollID(pollID).OrderByVotesDesc().Select(ctx)
+	if err != nil {
+		return models.PollResults{}, err
+	}
+
+	results := models.PollResults{
+		PollID: poll.ID,
+		Type:   poll.Type,
+	}
+	for _, o := range options {
+		results.Options = append(results.Options, pollOptionModel(o))
+	}
+
+	switch poll.Type {
+	case models.PollTypeApproval:
+		approvals, err := p.options.New().FilterPollID(pollID).OrderByVotesDesc().ApprovalCounts(ctx)
+		if err != nil {
+			return models.PollResults{}, err
+		}
+		for _, a := range approvals {
+			results.Approvals = append(results.Approvals, models.OptionTally{OptionID: a.OptionID, Votes: a.Approvals})
+		}
+
+	case models.PollTypeScore:
+		results.Scores, err = p.scores(ctx, poll)
+		if err != nil {
+			return models.PollResults{}, err
+		}
+
+	case models.PollTypeRanked:
+		ballots, err := p.ballots(ctx, pollID)
+		if err != nil {
+			return models.PollResults{}, err
+		}
+
+		optionIDs := pollOptionIDs(poll)
+		runoff := tally.InstantRunoff(optionIDs, ballots)
+		schulze := tally.Schulze(optionIDs, ballots)
+		results.Runoff = &runoff
+		results.Schulze = &schulze
+	}
+
+	return results, nil
+}
+
+// scores собирает среднюю оценку и распределение оценок по каждому варианту score-опроса.
+func (p Polls) scores(ctx context.Context, poll models.Poll) ([]models.OptionScore, error) {
+	stats, err := p.options.New().FilterPollID(poll.ID).OrderByPosition().ScoreStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	distribution, err := p.options.New().FilterPollID(poll.ID).ScoreDistribution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make(map[uuid.UUID][]int, len(stats))
+	for _, s := range stats {
+		buckets[s.OptionID] = make([]int, poll.MaxScore+1)
+	}
+	for _, d := range distribution {
+		if b, ok := buckets[d.OptionID]; ok && d.Score < len(b) {
+			b[d.Score] = d.Votes
+		}
+	}
+
+	out := make([]models.OptionScore, 0, len(stats))
+	for _, s := range stats {
+		out = append(out, models.OptionScore{
+			OptionID:     s.OptionID,
+			Ratings:      s.Ratings,
+			MeanScore:    s.MeanScore,
+			Distribution: buckets[s.OptionID],
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].MeanScore > out[j].MeanScore })
+	return out, nil
+}
+
+// ballots собирает ранжированные бюллетени опроса из строк poll_votes.
+func (p Polls) ballots(ctx context.Context, pollID uuid.UUID) ([]tally.Ballot, error) {
+	rows, err := p.votes.New().FilterPollID(pollID).OrderByBallot().Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ballots []tally.Ballot
+		current uuid.UUID
+	)
+	for i, v := range rows {
+		if i == 0 || v.BallotID != current {
+			ballots = append(ballots, nil)
+			current = v.BallotID
+		}
+		ballots[len(ballots)-1] = append(ballots[len(ballots)-1], v.OptionID)
+	}
+	return ballots, nil
+}
+
+// checkVotingOpen — голосовать можно только в опубликованном опросе до end_date.
+// Строка опроса блокируется, чтобы смена статуса не прошла между проверкой и голосом.
+func (p Polls) checkVotingOpen(ctx context.Context, pollID uuid.UUID) (models.Poll, error) {
+	poll, err := p.lock(ctx, pollID)
+	if err != nil {
+		return models.Poll{}, err
+	}
+	if poll.Status != models.PollStatusPublished {
+		return models.Poll{}, fmt.Errorf("poll %s is %s: %w", pollID, poll.Status, ErrVotingClosed)
+	}
+	if !time.Now().UTC().Before(poll.EndDate) {
+		return models.Poll{}, fmt.Errorf("poll %s ended at %s: %w", pollID, poll.EndDate, ErrVotingClosed)
+	}
+	return poll, nil
+}
+
+func pollChoices(poll models.Poll, choices []models.PollChoice) []dbx.PollChoice {
+	out := make([]dbx.PollChoice, 0, len(choices))
+	for i, c := range choices {
+		choice := dbx.PollChoice{OptionID: c.OptionID}
+		switch poll.Type {
+		case models.PollTypeRanked:
+			rank := i + 1
+			choice.Rank = &rank
+		case models.PollTypeScore:
+			choice.Score = c.Score
+		}
+		out = append(out, choice)
+	}
+	return out
+}
+
+func pollOptionIDs(poll models.Poll) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(poll.Options))
+	for _, o := range poll.Options {
+		ids = append(ids, o.ID)
+	}
+	return ids
+}
+
+func validateBallot(poll models.Poll, choices []models.PollChoice) error {
+	minChoices, maxChoices := poll.MinChoices, poll.MaxChoices
+	switch poll.Type {
+	case models.PollTypeApproval:
+		minChoices, maxChoices = 1, len(poll.Options)
+	case models.PollTypeScore:
+		minChoices, maxChoices = len(poll.Options), len(poll.Options)
+	}
+	if len(choices) < minChoices || len(choices) > maxChoices {
+		return fmt.Errorf("%w: %s poll %s requires %d..%d options, got %d",
+			ErrInvalidVote, poll.Type, poll.ID, minChoices, maxChoices, len(choices))
+	}
+
+	known := make(map[uuid.UUID]bool, len(poll.Options))
+	for _, o := range poll.Options {
+		known[o.ID] = true
+	}
+
+	seen := make(map[uuid.UUID]bool, len(choices))
+	for _, c := range choices {
+		if !known[c.OptionID] {
+			return fmt.Errorf("%w: option %s does not belong to poll %s", ErrInvalidVote, c.OptionID, poll.ID)
+		}
+		if seen[c.OptionID] {
+			return fmt.Errorf("%w: option %s selected twice", ErrInvalidVote, c.OptionID)
+		}
+		seen[c.OptionID] = true
+
+		if poll.Type != models.PollTypeScore {
+			if c.Score != nil {
+				return fmt.Errorf("%w: %s poll %s does not take scores", ErrInvalidVote, poll.Type, poll.ID)
+			}
+			continue
+		}
+		if c.Score == nil || *c.Score < 0 || *c.Score > poll.MaxScore {
+			return fmt.Errorf("%w: option %s needs a score in 0..%d", ErrInvalidVote, c.OptionID, poll.MaxScore)
+		}
+	}
+	return nil
+}
+
+// CloseExpired moves every published poll whose end_date has passed to closed.
+// It is a single conditional UPDATE, so concurrent calls from several replicas are harmless.
+func (p Polls) CloseExpired(ctx context.Context, now time.Time) error {
+	status := models.PollStatusClosed
+	return p.queries.New().
+		FilterStatus(models.PollStatusPublished).
+		FilterEndDateBefore(now).
+		Update(ctx, dbx.UpdatePollInput{Status: &status, UpdatedAt: &now})
+}
+
+func pollParties(actor Actor, poll models.Poll) party {
+	var who party
+	if actor.UserID == poll.InitiatorID {
+		who |= partyInitiator
+	}
+	if actor.ModeratorOf(poll.CityID) {
+		who |= partyModerator
+	}
+	return who
+}
+
+func pollModel(p dbx.Poll, options []dbx.PollOption) models.Poll {
+	m := models.Poll{
+		ID:          p.ID,
+		CityID:      p.CityID,
+		Title:       p.Title,
+		Description: p.Description,
+		Status:      p.Status,
+		Type:        p.Type,
+		Secret:      p.Secret,
+		InitiatorID: p.InitiatorID,
+		MinChoices:  p.MinChoices,
+		MaxChoices:  p.MaxChoices,
+		MaxScore:    p.MaxScore,
+		EndDate:     p.EndDate,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Location:    locationModel(p.Lat, p.Lng),
+		Rank:        p.Rank,
+		Snippet:     p.Snippet,
+	}
+	for _, o := range options {
+		m.Options = append(m.Options, pollOptionModel(o))
+	}
+	return m
+}
+
+func pollOptionModel(o dbx.PollOption) models.PollOption {
+	return models.PollOption{
+		ID:         o.ID,
+		PollID:     o.PollID,
+		OptionText: o.OptionText,
+		VotesCount: o.VotesCount,
+		Position:   o.Position,
+		CreatedAt:  o.CreatedAt,
+	}
+}
+
+func pollVoteModel(v dbx.PollVote) models.PollVote {
+	return models.PollVote{
+		ID:        v.ID,
+		PollID:    v.PollID,
+		BallotID:  v.BallotID,
+		UserID:    v.UserID,
+		OptionID:  v.OptionID,
+		Rank:      v.Rank,
+		Score:     v.Score,
+		CreatedAt: v.CreatedAt,
+	}
 }
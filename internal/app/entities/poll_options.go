@@ -0,0 +1,253 @@
+package entities
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/google/uuid"
+)
+
+// AddOption appends an option to the end of the poll. Like every option edit it is available to the initiator
+// only while the poll is processed or has no votes yet. A max_choices equal to the number of options
+// ("pick or rank them all") grows with it; a smaller one stays as it was set.
+func (p Polls) AddOption(ctx context.Context, actor Actor, pollID uuid.UUID, text string) (models.Poll, error) {
+	text, err := optionText("text", text)
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return p.editOptions(ctx, actor, pollID, func(ctx context.Context, poll models.Poll) error {
+		if len(poll.Options) >= maxPollOptions {
+			return ape.InvalidField("text", "poll already has the maximum of %d options", maxPollOptions)
+		}
+		if err := checkOptionUnique(poll, uuid.Nil, text); err != nil {
+			return err
+		}
+
+		return p.options.New().Insert(ctx, dbx.InsertPollOptionInput{
+			ID:         uuid.New(),
+			PollID:     poll.ID,
+			OptionText: text,
+			Position:   len(poll.Options),
+			CreatedAt:  time.Now().UTC(),
+		})
+	})
+}
+
+// EditOption changes the text of an option.
+func (p Polls) EditOption(ctx context.Context, actor Actor, pollID, optionID uuid.UUID, text string) (models.Poll, error) {
+	text, err := optionText("text", text)
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return p.editOptions(ctx, actor, pollID, func(ctx context.Context, poll models.Poll) error {
+		if _, err := optionIndex(poll, optionID); err != nil {
+			return err
+		}
+		if err := checkOptionUnique(poll, optionID, text); err != nil {
+			return err
+		}
+
+		return p.options.New().FilterID(optionID).Update(ctx, dbx.UpdatePollOptionInput{OptionText: &text})
+	})
+}
+
+// RemoveOption deletes an option and closes the gap in the order of the rest. The poll keeps at least
+// two options and enough of them for its selection limits.
+func (p Polls) RemoveOption(ctx context.Context, actor Actor, pollID, optionID uuid.UUID) (models.Poll, error) {
+	return p.editOptions(ctx, actor, pollID, func(ctx context.Context, poll models.Poll) error {
+		idx, err := optionIndex(poll, optionID)
+		if err != nil {
+			return err
+		}
+
+		if err = checkOptionRemovable(poll); err != nil {
+			return err
+		}
+		left := len(poll.Options) - 1
+
+		// голосов в опросе нет (см. editOptions), так что удаление ничего не каскадирует
+		if err = p.options.New().FilterID(optionID).Delete(ctx); err != nil {
+			return err
+		}
+
+		rest := make([]uuid.UUID, 0, left)
+		for i, o := range poll.Options {
+			if i != idx {
+				rest = append(rest, o.ID)
+			}
+		}
+		return p.setPositions(ctx, poll, rest)
+	})
+}
+
+// ReorderOptions puts the options in the given order; optionIDs must list every option of the poll exactly once.
+func (p Polls) ReorderOptions(ctx context.Context, actor Actor, pollID uuid.UUID, optionIDs []uuid.UUID) (models.Poll, error) {
+	return p.editOptions(ctx, actor, pollID, func(ctx context.Context, poll models.Poll) error {
+		if len(optionIDs) != len(poll.Options) {
+			return ape.InvalidField("option_ids", "expected all %d options of the poll, got %d", len(poll.Options), len(optionIDs))
+		}
+
+		seen := make(map[uuid.UUID]bool, len(optionIDs))
+		for i, id := range optionIDs {
+			if _, err := optionIndex(poll, id); err != nil {
+				return ape.InvalidField(fmt.Sprintf("option_ids[%d]", i), "option %s does not belong to the poll", id)
+			}
+			if seen[id] {
+				return ape.InvalidField(fmt.Sprintf("option_ids[%d]", i), "option %s listed twice", id)
+			}
+			seen[id] = true
+		}
+
+		return p.setPositions(ctx, poll, optionIDs)
+	})
+}
+
+// editOptions блокирует опрос и выполняет fn, если actor — инициатор, а варианты ещё не заперты.
+// Vote блокирует ту же строку, так что первый голос не может проскочить между проверкой и правкой.
+// Возвращает опрос с обновлёнными вариантами.
+func (p Polls) editOptions(ctx context.Context, actor Actor, pollID uuid.UUID, fn func(ctx context.Context, poll models.Poll) error) (models.Poll, error) {
+	var updated models.Poll
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		poll, err := p.lock(ctx, pollID)
+		if err != nil {
+			return err
+		}
+		if err = checkOptionsEditor(actor, poll); err != nil {
+			return err
+		}
+		if err = p.checkOptionsEditable(ctx, poll); err != nil {
+			return err
+		}
+
+		if err = fn(ctx, poll); err != nil {
+			return err
+		}
+
+		count, err := p.options.New().FilterPollID(pollID).Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		update := dbx.UpdatePollInput{UpdatedAt: &now}
+		if n := int(count); followsOptionCount(poll) && n != poll.MaxChoices {
+			update.MaxChoices = &n
+		}
+		if err = p.queries.New().FilterID(pollID).Update(ctx, update); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, pollID)
+		return err
+	})
+	if err != nil {
+		return models.Poll{}, err
+	}
+
+	return updated, nil
+}
+
+// checkOptionsEditor — варианты правит только инициатор опроса, модератор города — нет.
+func checkOptionsEditor(actor Actor, poll models.Poll) error {
+	if pollParties(actor, poll)&partyInitiator == 0 {
+		return fmt.Errorf("%w: options of poll %s are edited by its initiator", ErrForbidden, poll.ID)
+	}
+	return nil
+}
+
+// checkOptionsEditable — варианты можно менять, пока опрос на модерации или опубликован, но без голосов.
+// В тайных опросах бюллетень пишется позже факта участия (см. Vote), поэтому считаются участники.
+func (p Polls) checkOptionsEditable(ctx context.Context, poll models.Poll) error {
+	switch poll.Status {
+	case models.PollStatusProcessed:
+		return nil
+	case models.PollStatusPublished:
+		var votes uint64
+		var err error
+		if poll.Secret {
+			votes, err = p.participants.New().FilterPollID(poll.ID).Count(ctx)
+		} else {
+			votes, err = p.votes.New().FilterPollID(poll.ID).Count(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		if votes > 0 {
+			return fmt.Errorf("%w: poll %s already has votes", ErrOptionsLocked, poll.ID)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: poll %s is %s", ErrOptionsLocked, poll.ID, poll.Status)
+	}
+}
+
+// setPositions нумерует варианты по порядку ids с нуля; строки, чья позиция не изменилась, не трогаются.
+func (p Polls) setPositions(ctx context.Context, poll models.Poll, ids []uuid.UUID) error {
+	current := make(map[uuid.UUID]int, len(poll.Options))
+	for _, o := range poll.Options {
+		current[o.ID] = o.Position
+	}
+
+	for i, id := range ids {
+		if pos, ok := current[id]; ok && pos == i {
+			continue
+		}
+		position := i
+		if err := p.options.New().FilterID(id).Update(ctx, dbx.UpdatePollOptionInput{Position: &position}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkOptionUnique — текст не должен совпадать (без учёта регистра) с другим вариантом опроса;
+// except — вариант, который сейчас правится.
+func checkOptionUnique(poll models.Poll, except uuid.UUID, text string) error {
+	for _, o := range poll.Options {
+		if o.ID != except && strings.ToLower(o.OptionText) == strings.ToLower(text) {
+			return ape.InvalidField("text", "duplicates option %s", o.ID)
+		}
+	}
+	return nil
+}
+
+func optionIndex(poll models.Poll, optionID uuid.UUID) (int, error) {
+	for i, o := range poll.Options {
+		if o.ID == optionID {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("option %s of poll %s: %w", optionID, poll.ID, ErrNotFound)
+}
+
+// limitedChoices — опрос с явными лимитами выбора (choice и ranked).
+func limitedChoices(poll models.Poll) bool {
+	return poll.Type == models.PollTypeChoice || poll.Type == models.PollTypeRanked
+}
+
+// followsOptionCount — max_choices меняется вместе с числом вариантов: у approval и score всегда,
+// у choice и ranked — если он равен числу вариантов («выбрать или ранжировать все»).
+func followsOptionCount(poll models.Poll) bool {
+	return !limitedChoices(poll) || poll.MaxChoices == len(poll.Options)
+}
+
+// checkOptionRemovable — после удаления варианта у опроса остаётся не меньше minPollOptions вариантов
+// и их хватает на его лимиты выбора.
+func checkOptionRemovable(poll models.Poll) error {
+	left := len(poll.Options) - 1
+	switch {
+	case left < minPollOptions:
+		return ape.InvalidField("option_id", "poll needs at least %d options", minPollOptions)
+	case limitedChoices(poll) && poll.MinChoices > left:
+		// max_choices не мешает: либо он меньше числа вариантов, либо уменьшится вместе с ним
+		return ape.InvalidField("option_id", "poll requires at least %d choices and cannot have fewer options", poll.MinChoices)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/google/uuid"
+)
+
+// countStub — драйвер без базы: на COUNT(*) по таблице отвечает заданным числом и запоминает, что считали.
+type countStub struct {
+	counts  map[string]int64 // таблица → результат COUNT(*)
+	counted []string
+}
+
+func (s *countStub) Connect(context.Context) (driver.Conn, error) { return countStubConn{s}, nil }
+func (s *countStub) Driver() driver.Driver                        { return nil }
+
+type countStubConn struct{ s *countStub }
+
+func (c countStubConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c countStubConn) Close() error                        { return nil }
+func (c countStubConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c countStubConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	for table, n := range c.s.counts {
+		if strings.HasPrefix(query, "SELECT COUNT(*) AS count FROM "+table+" ") {
+			c.s.counted = append(c.s.counted, table)
+			return &countRows{n: n}, nil
+		}
+	}
+	return nil, errors.New("unexpected query: " + query)
+}
+
+type countRows struct {
+	n    int64
+	done bool
+}
+
+func (r *countRows) Columns() []string { return []string{"count"} }
+func (r *countRows) Close() error      { return nil }
+func (r *countRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.n
+	return nil
+}
+
+func TestCheckOptionsEditable(t *testing.T) {
+	tests := map[string]struct {
+		status  string
+		secret  bool
+		votes   int64
+		voters  int64 // строки poll_participants
+		locked  bool
+		counted string // какую таблицу нужно посчитать; пусто — никакую
+	}{
+		"processed":                              {status: models.PollStatusProcessed},
+		"published without votes":                {status: models.PollStatusPublished, counted: "poll_votes"},
+		"published with votes":                   {status: models.PollStatusPublished, votes: 1, locked: true, counted: "poll_votes"},
+		"secret without participants":            {status: models.PollStatusPublished, secret: true, counted: "poll_participants"},
+		"secret with a participant, no ballots":  {status: models.PollStatusPublished, secret: true, voters: 1, locked: true, counted: "poll_participants"},
+		"secret with ballots but no participant": {status: models.PollStatusPublished, secret: true, votes: 3, counted: "poll_participants"},
+		"declined":                               {status: models.PollStatusDeclined, locked: true},
+		"withdrawn":                              {status: models.PollStatusWithdrawn, locked: true},
+		"closed":                                 {status: models.PollStatusClosed, locked: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stub := &countStub{counts: map[string]int64{"poll_votes": tt.votes, "poll_participants": tt.voters}}
+			db := sql.OpenDB(stub)
+			t.Cleanup(func() { _ = db.Close() })
+
+			p := Polls{votes: dbx.NewPollVotesQ(db), participants: dbx.NewPollParticipantsQ(db)}
+			err := p.checkOptionsEditable(context.Background(), models.Poll{ID: uuid.New(), Status: tt.status, Secret: tt.secret})
+
+			if tt.locked != errors.Is(err, ErrOptionsLocked) {
+				t.Fatalf("err = %v, locked = %v", err, tt.locked)
+			}
+			if !tt.locked && err != nil {
+				t.Fatalf("checkOptionsEditable: %v", err)
+			}
+			if got := strings.Join(stub.counted, ","); got != tt.counted {
+				t.Fatalf("counted %q, want %q", got, tt.counted)
+			}
+		})
+	}
+}
+
+// Варианты правит только инициатор: ни модератор города, ни другие пользователи.
+func TestCheckOptionsEditor(t *testing.T) {
+	city := uuid.New()
+	initiator := uuid.New()
+	poll := models.Poll{ID: uuid.New(), CityID: city, InitiatorID: initiator}
+
+	tests := map[string]struct {
+		actor   Actor
+		allowed bool
+	}{
+		"initiator":               {actor: Actor{UserID: initiator}, allowed: true},
+		"initiator and moderator": {actor: Actor{UserID: initiator, Roles: []string{RoleModerator}, CityID: &city}, allowed: true},
+		"moderator of the city":   {actor: Actor{UserID: uuid.New(), Roles: []string{RoleModerator}, CityID: &city}},
+		"city government":         {actor: Actor{UserID: uuid.New(), Roles: []string{RoleCityGov}, CityID: &city}},
+		"another user":            {actor: Actor{UserID: uuid.New()}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkOptionsEditor(tt.actor, poll)
+			if tt.allowed && err != nil {
+				t.Fatalf("checkOptionsEditor: %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrForbidden) {
+				t.Fatalf("err = %v, want forbidden", err)
+			}
+		})
+	}
+}
+
+func TestOptionLimits(t *testing.T) {
+	options := func(n int) []models.PollOption { return make([]models.PollOption, n) }
+
+	tests := map[string]struct {
+		poll      models.Poll
+		follows   bool // max_choices меняется вместе с числом вариантов
+		removable bool
+	}{
+		"single choice":         {poll: models.Poll{Type: models.PollTypeChoice, MinChoices: 1, MaxChoices: 1, Options: options(3)}, removable: true},
+		"choice up to two":      {poll: models.Poll{Type: models.PollTypeChoice, MinChoices: 1, MaxChoices: 2, Options: options(3)}, removable: true},
+		"choice up to all":      {poll: models.Poll{Type: models.PollTypeChoice, MinChoices: 1, MaxChoices: 3, Options: options(3)}, follows: true, removable: true},
+		"ranked all":            {poll: models.Poll{Type: models.PollTypeRanked, MinChoices: 1, MaxChoices: 4, Options: options(4)}, follows: true, removable: true},
+		"ranked top three of 4": {poll: models.Poll{Type: models.PollTypeRanked, MinChoices: 1, MaxChoices: 3, Options: options(4)}, removable: true},
+		"ranked top three of 3": {poll: models.Poll{Type: models.PollTypeRanked, MinChoices: 1, MaxChoices: 3, Options: options(3)}, follows: true, removable: true},
+		"choice exactly two":    {poll: models.Poll{Type: models.PollTypeChoice, MinChoices: 2, MaxChoices: 2, Options: options(3)}, removable: true},
+		"ranked must rank all":  {poll: models.Poll{Type: models.PollTypeRanked, MinChoices: 3, MaxChoices: 3, Options: options(3)}, follows: true},
+		"choice up to 3 of 4":   {poll: models.Poll{Type: models.PollTypeChoice, MinChoices: 1, MaxChoices: 3, Options: options(4)}, removable: true},
+		"two options left":      {poll: models.Poll{Type: models.PollTypeChoice, MinChoices: 1, MaxChoices: 1, Options: options(2)}},
+		"approval":              {poll: models.Poll{Type: models.PollTypeApproval, MinChoices: 1, MaxChoices: 3, Options: options(3)}, follows: true, removable: true},
+		"score":                 {poll: models.Poll{Type: models.PollTypeScore, MinChoices: 1, MaxChoices: 3, Options: options(3)}, follows: true, removable: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := followsOptionCount(tt.poll); got != tt.follows {
+				t.Fatalf("followsOptionCount = %v, want %v", got, tt.follows)
+			}
+			err := checkOptionRemovable(tt.poll)
+			if tt.removable && err != nil {
+				t.Fatalf("checkOptionRemovable: %v", err)
+			}
+			if !tt.removable && !errors.Is(err, ErrInvalidInput) {
+				t.Fatalf("err = %v, want invalid input", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/app/mixer"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/google/uuid"
+)
+
+// execRecorder — драйвер без базы: запоминает выполненные команды и то, был ли жив их контекст.
+type execRecorder struct {
+	mu    sync.Mutex
+	execs []recordedExec
+}
+
+type recordedExec struct {
+	query    string
+	args     []any
+	ctxErr   error
+	deadline bool
+}
+
+func (r *execRecorder) Connect(context.Context) (driver.Conn, error) { return execRecorderConn{r}, nil }
+func (r *execRecorder) Driver() driver.Driver                        { return nil }
+
+type execRecorderConn struct{ r *execRecorder }
+
+func (c execRecorderConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+func (c execRecorderConn) Close() error              { return nil }
+func (c execRecorderConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+func (c execRecorderConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	e := recordedExec{query: query, ctxErr: ctx.Err()}
+	_, e.deadline = ctx.Deadline()
+	for _, a := range args {
+		e.args = append(e.args, a.Value)
+	}
+
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+	c.r.execs = append(c.r.execs, e)
+	return driver.RowsAffected(1), nil
+}
+
+// Если батч с тайным бюллетенем не записался, строка участия удаляется, даже когда запрос
+// голосующего уже отменён: иначе он больше не смог бы проголосовать.
+func TestCastSecretBallotRemovesParticipationOnFailedBatch(t *testing.T) {
+	rec := &execRecorder{}
+	db := sql.OpenDB(rec)
+	t.Cleanup(func() { _ = db.Close() })
+
+	errFlush := errors.New("batch failed")
+	p := Polls{
+		db:           db,
+		participants: dbx.NewPollParticipantsQ(db),
+		secretBallots: mixer.New(func(context.Context, []dbx.AnonymousBallot) error {
+			return errFlush
+		}, 1, time.Hour),
+	}
+
+	actor := Actor{UserID: uuid.New()}
+	ballot := dbx.AnonymousBallot{PollID: uuid.New()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := p.castSecretBallot(ctx, actor, ballot)
+	if !errors.Is(err, errFlush) {
+		t.Fatalf("err = %v, want the batch error", err)
+	}
+	if strings.Contains(err.Error(), "removing participation") {
+		t.Fatalf("participation was not removed: %v", err)
+	}
+
+	if len(rec.execs) != 1 {
+		t.Fatalf("got %d statements, want one delete", len(rec.execs))
+	}
+	e := rec.execs[0]
+	if !strings.HasPrefix(e.query, "DELETE FROM poll_participants WHERE") {
+		t.Fatalf("unexpected statement: %s", e.query)
+	}
+	if len(e.args) != 2 || e.args[0] != ballot.PollID.String() || e.args[1] != actor.UserID.String() {
+		t.Fatalf("args = %v, want poll %s and user %s", e.args, ballot.PollID, actor.UserID)
+	}
+	if e.ctxErr != nil || !e.deadline {
+		t.Fatalf("delete ran with ctx err %v, deadline %v; want a live context with its own timeout", e.ctxErr, e.deadline)
+	}
+}
+
+func TestCastSecretBallotKeepsParticipationOnWrittenBatch(t *testing.T) {
+	rec := &execRecorder{}
+	db := sql.OpenDB(rec)
+	t.Cleanup(func() { _ = db.Close() })
+
+	p := Polls{
+		db:           db,
+		participants: dbx.NewPollParticipantsQ(db),
+		secretBallots: mixer.New(func(context.Context, []dbx.AnonymousBallot) error {
+			return nil
+		}, 1, time.Hour),
+	}
+
+	if err := p.castSecretBallot(context.Background(), Actor{UserID: uuid.New()}, dbx.AnonymousBallot{PollID: uuid.New()}); err != nil {
+		t.Fatalf("castSecretBallot: %v", err)
+	}
+	if len(rec.execs) != 0 {
+		t.Fatalf("participation touched after a written batch: %v", rec.execs)
+	}
+}
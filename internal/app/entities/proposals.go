@@ -2,7 +2,12 @@ package entities
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/chains-lab/voting-svc/internal/app/models"
 	"github.com/chains-lab/voting-svc/internal/dbx"
 	"github.com/google/uuid"
 )
@@ -20,20 +25,25 @@ type proposalsQ interface {
 	FilterCityID(cityID uuid.UUID) dbx.ProposalsQ
 	FilterInitiatorID(initiatorID uuid.UUID) dbx.ProposalsQ
 	FilterStatus(status string) dbx.ProposalsQ
+	FilterEndDateBefore(t time.Time) dbx.ProposalsQ
+	ForUpdate() dbx.ProposalsQ
 	FilterAddressedTo(addressToID uuid.UUID) dbx.ProposalsQ
 	FilterAddressedToCityGov() dbx.ProposalsQ
 
 	TitleLike(s string) dbx.ProposalsQ
+	Search(text string, configs ...string) dbx.ProposalsQ
 
 	BBox(minLng, minLat, maxLng, maxLat float64) dbx.ProposalsQ
 	WithinRadius(lng, lat, radiusMeters float64) dbx.ProposalsQ
 
 	OrderByCreatedAsc() dbx.ProposalsQ
 	OrderByCreatedDesc() dbx.ProposalsQ
+	OrderByRank() dbx.ProposalsQ
 	OrderByAgreedDesc() dbx.ProposalsQ
 	OrderByDisagreedDesc() dbx.ProposalsQ
 
-	Page(limit, offset uint64) dbx.ProposalsQ
+	Page(limit uint64, after *dbx.Cursor) (dbx.ProposalsQ, error)
+	Cursor(r dbx.Proposal) dbx.Cursor
 	Count(ctx context.Context) (uint64, error)
 }
 
@@ -54,6 +64,513 @@ type proposalVotesQ interface {
 	OrderByCreatedAsc() dbx.ProposalVotesQ
 	OrderByCreatedDesc() dbx.ProposalVotesQ
 
-	Page(limit, offset uint64) dbx.ProposalVotesQ
+	Page(limit uint64, after *dbx.Cursor) (dbx.ProposalVotesQ, error)
+	Cursor(r dbx.ProposalVote) dbx.Cursor
 	Count(ctx context.Context) (uint64, error)
 }
+
+var proposalTransitions = transitions{
+	models.ProposalStatusProcessed: {
+		models.ProposalStatusPublished: partyModerator,
+		models.ProposalStatusDeclined:  partyModerator,
+		models.ProposalStatusWithdrawn: partyInitiator | partyModerator,
+	},
+	models.ProposalStatusPublished: {
+		models.ProposalStatusWithdrawn: partyInitiator | partyModerator,
+		models.ProposalStatusApproved:  partyAddressee,
+		models.ProposalStatusRejected:  partyAddressee,
+	},
+	models.ProposalStatusClosed: {
+		models.ProposalStatusApproved: partyAddressee,
+		models.ProposalStatusRejected: partyAddressee,
+	},
+}
+
+type Proposals struct {
+	db         *sql.DB
+	queries    proposalsQ
+	votes      proposalVotesQ
+	moderation moderationLogQ
+}
+
+func NewProposals(db *sql.DB) Proposals {
+	return Proposals{
+		db:         db,
+		queries:    dbx.NewProposalsQ(db),
+		votes:      dbx.NewProposalVotesQ(db),
+		moderation: dbx.NewModerationLogQ(db),
+	}
+}
+
+func (p Proposals) Get(ctx context.Context, id uuid.UUID) (models.Proposal, error) {
+	return p.get(ctx, id, p.queries.New().FilterID(id))
+}
+
+// CreateProposalInput is a new proposal as submitted by its initiator.
+type CreateProposalInput struct {
+	Draft
+	AddressToID *uuid.UUID // nil addresses the city government
+}
+
+// Create submits a proposal on behalf of the actor. It stays processed until a moderator publishes it.
+func (p Proposals) Create(ctx context.Context, actor Actor, in CreateProposalInput) (models.Proposal, error) {
+	now := time.Now().UTC()
+	if err := in.validate(now); err != nil {
+		return models.Proposal{}, err
+	}
+
+	id := uuid.New()
+	err := p.queries.New().Insert(ctx, dbx.InsertProposalInput{
+		ID:          id,
+		CityID:      in.CityID,
+		Title:       in.Title,
+		Description: in.Description,
+		Status:      models.ProposalStatusProcessed,
+		InitiatorID: actor.UserID,
+		AddressToID: in.AddressToID,
+		EndDate:     in.EndDate.UTC(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Location:    geoPoint(in.Location),
+	})
+	if err != nil {
+		return models.Proposal{}, err
+	}
+
+	return p.Get(ctx, id)
+}
+
+// List returns a page of proposals matching the filter, newest first (most relevant first when searching),
+// and the total number of matches.
+func (p Proposals) List(ctx context.Context, filter ListFilter, page PageRequest) ([]models.Proposal, uint64, string, error) {
+	if err := filter.validate(); err != nil {
+		return nil, 0, "", err
+	}
+
+	q := p.filter(filter)
+	if filter.Query != "" {
+		q = q.OrderByRank()
+	} else {
+		q = q.OrderByCreatedDesc()
+	}
+	return p.list(ctx, q, page)
+}
+
+// Queue returns the proposals of the city waiting for moderation, oldest first.
+func (p Proposals) Queue(ctx context.Context, actor Actor, cityID uuid.UUID, page PageRequest) ([]models.Proposal, uint64, string, error) {
+	if err := checkModerator(actor, "proposal", cityID); err != nil {
+		return nil, 0, "", err
+	}
+
+	q := p.filter(ListFilter{CityID: &cityID, Status: models.ProposalStatusProcessed})
+	return p.list(ctx, q.OrderByCreatedAsc(), page)
+}
+
+func (p Proposals) filter(filter ListFilter) dbx.ProposalsQ {
+	q := p.queries.New()
+	if filter.CityID != nil {
+		q = q.FilterCityID(*filter.CityID)
+	}
+	if filter.InitiatorID != nil {
+		q = q.FilterInitiatorID(*filter.InitiatorID)
+	}
+	if filter.Status != "" {
+		q = q.FilterStatus(filter.Status)
+	}
+	if filter.Title != "" {
+		q = q.TitleLike(filter.Title)
+	}
+	if filter.Query != "" {
+		q = q.Search(filter.Query, filter.searchConfigs()...)
+	}
+	if b := filter.BBox; b != nil {
+		q = q.BBox(b.MinLng, b.MinLat, b.MaxLng, b.MaxLat)
+	}
+	return q
+}
+
+func (p Proposals) list(ctx context.Context, q dbx.ProposalsQ, page PageRequest) ([]models.Proposal, uint64, string, error) {
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	q, err = applyPage(q, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, err := q.Select(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	rows, next := nextPage(rows, page, q.Cursor)
+
+	proposals := make([]models.Proposal, 0, len(rows))
+	for _, row := range rows {
+		proposals = append(proposals, proposalModel(row))
+	}
+	return proposals, total, next, nil
+}
+
+// lock reads the proposal with FOR UPDATE; must be called inside dbx.Transaction.
+func (p Proposals) lock(ctx context.Context, id uuid.UUID) (models.Proposal, error) {
+	return p.get(ctx, id, p.queries.New().FilterID(id).ForUpdate())
+}
+
+func (p Proposals) get(ctx context.Context, id uuid.UUID, q dbx.ProposalsQ) (models.Proposal, error) {
+	proposal, err := q.Get(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Proposal{}, fmt.Errorf("proposal %s: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return models.Proposal{}, err
+	}
+	return proposalModel(proposal), nil
+}
+
+// AvailableStatuses returns the statuses the actor may move the proposal to.
+func (p Proposals) AvailableStatuses(actor Actor, proposal models.Proposal) []string {
+	return proposalTransitions.available(proposal.Status, proposalParties(actor, proposal))
+}
+
+// ChangeStatus moves the proposal to the given status. Approved/rejected are final verdicts:
+// the deciding actor and the tally at that moment are recorded together with the status.
+func (p Proposals) ChangeStatus(ctx context.Context, actor Actor, id uuid.UUID, status string) (models.Proposal, error) {
+	var updated models.Proposal
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		proposal, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = proposalTransitions.check("proposal", proposal.Status, status, proposalParties(actor, proposal))
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		in := dbx.UpdateProposalInput{
+			Status:    &status,
+			UpdatedAt: &now,
+		}
+		if status == models.ProposalStatusApproved || status == models.ProposalStatusRejected {
+			in.DecidedBy = &actor.UserID
+			in.DecidedAt = &now
+			in.FreezeTally = true
+		}
+
+		if err = p.queries.New().FilterID(id).Update(ctx, in); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return models.Proposal{}, err
+	}
+
+	return updated, nil
+}
+
+// CloseExpired moves every published proposal whose end_date has passed to closed, where it awaits the verdict.
+// It is a single conditional UPDATE, so concurrent calls from several replicas are harmless.
+func (p Proposals) CloseExpired(ctx context.Context, now time.Time) error {
+	status := models.ProposalStatusClosed
+	return p.queries.New().
+		FilterStatus(models.ProposalStatusPublished).
+		FilterEndDateBefore(now).
+		Update(ctx, dbx.UpdateProposalInput{Status: &status, UpdatedAt: &now})
+}
+
+// Moderate moves the proposal to published, declined or withdrawn on behalf of a moderator of its city
+// and records the action with its reason in the moderation log.
+func (p Proposals) Moderate(ctx context.Context, actor Actor, id uuid.UUID, status, reason string) (models.Proposal, error) {
+	action, err := moderationAction(status, reason)
+	if err != nil {
+		return models.Proposal{}, err
+	}
+
+	var updated models.Proposal
+	err = dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		proposal, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "proposal", proposal.CityID); err != nil {
+			return err
+		}
+
+		if updated, err = p.ChangeStatus(ctx, actor, id, status); err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityProposal, id, proposal.CityID, action, reason, nil)
+		return p.moderation.New().Insert(ctx, entry)
+	})
+	if err != nil {
+		return models.Proposal{}, err
+	}
+
+	return updated, nil
+}
+
+// Edit lets a moderator of the proposal's city correct its title or description.
+func (p Proposals) Edit(ctx context.Context, actor Actor, id uuid.UUID, edit ContentEdit, reason string) (models.Proposal, error) {
+	if err := edit.validate(); err != nil {
+		return models.Proposal{}, err
+	}
+	if err := checkReason(reason); err != nil {
+		return models.Proposal{}, err
+	}
+
+	var updated models.Proposal
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		proposal, err := p.lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "proposal", proposal.CityID); err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		err = p.queries.New().FilterID(id).Update(ctx, dbx.UpdateProposalInput{
+			Title:       edit.Title,
+			Description: edit.Description,
+			UpdatedAt:   &now,
+		})
+		if err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityProposal, id, proposal.CityID, models.ModerationEdit, reason, nil)
+		if err = p.moderation.New().Insert(ctx, entry); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return models.Proposal{}, err
+	}
+
+	return updated, nil
+}
+
+// RemoveVote deletes an abusive vote of the given user; the counters follow through the trigger.
+func (p Proposals) RemoveVote(ctx context.Context, actor Actor, proposalID, userID uuid.UUID, reason string) (models.Proposal, error) {
+	if err := checkReason(reason); err != nil {
+		return models.Proposal{}, err
+	}
+
+	var updated models.Proposal
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		proposal, err := p.lock(ctx, proposalID)
+		if err != nil {
+			return err
+		}
+		if err = checkModerator(actor, "proposal", proposal.CityID); err != nil {
+			return err
+		}
+
+		_, err = p.votes.New().FilterProposalID(proposalID).FilterUserID(userID).Get(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("vote of user %s on proposal %s: %w", userID, proposalID, ErrNotFound)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = p.votes.New().FilterProposalID(proposalID).FilterUserID(userID).Delete(ctx); err != nil {
+			return err
+		}
+
+		entry := moderationEntry(actor, models.EntityProposal, proposalID, proposal.CityID, models.ModerationDeleteVote, reason, &userID)
+		if err = p.moderation.New().Insert(ctx, entry); err != nil {
+			return err
+		}
+
+		updated, err = p.Get(ctx, proposalID)
+		return err
+	})
+	if err != nil {
+		return models.Proposal{}, err
+	}
+
+	return updated, nil
+}
+
+// Decide issues the final verdict of the addressee.
+func (p Proposals) Decide(ctx context.Context, actor Actor, id uuid.UUID, approved bool) (models.Proposal, error) {
+	if approved {
+		return p.ChangeStatus(ctx, actor, id, models.ProposalStatusApproved)
+	}
+	return p.ChangeStatus(ctx, actor, id, models.ProposalStatusRejected)
+}
+
+// Vote casts the actor's vote or changes the existing one.
+func (p Proposals) Vote(ctx context.Context, actor Actor, proposalID uuid.UUID, agree bool) (models.ProposalVote, error) {
+	var vote dbx.ProposalVote
+	err := dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		if err := p.checkVotingOpen(ctx, proposalID); err != nil {
+			return err
+		}
+
+		var err error
+		vote, err = p.votes.New().FilterProposalID(proposalID).FilterUserID(actor.UserID).Get(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			vote = dbx.ProposalVote{
+				ID:         uuid.New(),
+				ProposalID: proposalID,
+				UserID:     actor.UserID,
+				Vote:       agree,
+				CreatedAt:  time.Now().UTC(),
+			}
+			err = p.votes.New().Insert(ctx, dbx.InsertProposalVoteInput{
+				ID:         vote.ID,
+				ProposalID: vote.ProposalID,
+				UserID:     vote.UserID,
+				Vote:       vote.Vote,
+				CreatedAt:  vote.CreatedAt,
+			})
+			// параллельный голос того же пользователя записался первым
+			if dbx.IsUniqueViolation(err, dbx.ProposalVoteUserKey) {
+				return fmt.Errorf("proposal %s: %w", proposalID, ErrAlreadyVoted)
+			}
+			return err
+		case err != nil:
+			return err
+		case vote.Vote != agree:
+			vote.Vote = agree
+			return p.votes.New().FilterID(vote.ID).Update(ctx, dbx.UpdateProposalVoteInput{Vote: &agree})
+		}
+		return nil
+	})
+	if err != nil {
+		return models.ProposalVote{}, err
+	}
+
+	return proposalVoteModel(vote), nil
+}
+
+// RetractVote removes the actor's vote while voting is still open.
+func (p Proposals) RetractVote(ctx context.Context, actor Actor, proposalID uuid.UUID) error {
+	return dbx.Transaction(ctx, p.db, func(ctx context.Context) error {
+		if err := p.checkVotingOpen(ctx, proposalID); err != nil {
+			return err
+		}
+
+		_, err := p.votes.New().FilterProposalID(proposalID).FilterUserID(actor.UserID).Get(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("vote on proposal %s: %w", proposalID, ErrNotFound)
+		}
+		if err != nil {
+			return err
+		}
+
+		return p.votes.New().FilterProposalID(proposalID).FilterUserID(actor.UserID).Delete(ctx)
+	})
+}
+
+// Tally returns the current vote counters of the proposal.
+func (p Proposals) Tally(ctx context.Context, proposalID uuid.UUID) (models.ProposalTally, error) {
+	proposal, err := p.Get(ctx, proposalID)
+	if err != nil {
+		return models.ProposalTally{}, err
+	}
+
+	return models.ProposalTally{
+		ProposalID:   proposal.ID,
+		AgreedNum:    proposal.AgreedNum,
+		DisagreedNum: proposal.DisagreedNum,
+		UpdatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// UserVote returns the actor's vote on the proposal.
+func (p Proposals) UserVote(ctx context.Context, actor Actor, proposalID uuid.UUID) (models.ProposalVote, error) {
+	vote, err := p.votes.New().FilterProposalID(proposalID).FilterUserID(actor.UserID).Get(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ProposalVote{}, fmt.Errorf("vote on proposal %s: %w", proposalID, ErrNotFound)
+	}
+	if err != nil {
+		return models.ProposalVote{}, err
+	}
+	return proposalVoteModel(vote), nil
+}
+
+// checkVotingOpen — голосовать можно только по опубликованному предложению до end_date.
+// Строка предложения блокируется, чтобы смена статуса не прошла между проверкой и голосом.
+func (p Proposals) checkVotingOpen(ctx context.Context, proposalID uuid.UUID) error {
+	proposal, err := p.lock(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	if proposal.Status != models.ProposalStatusPublished {
+		return fmt.Errorf("proposal %s is %s: %w", proposalID, proposal.Status, ErrVotingClosed)
+	}
+	if !time.Now().UTC().Before(proposal.EndDate) {
+		return fmt.Errorf("proposal %s ended at %s: %w", proposalID, proposal.EndDate, ErrVotingClosed)
+	}
+	return nil
+}
+
+func proposalParties(actor Actor, proposal models.Proposal) party {
+	var who party
+	if actor.UserID == proposal.InitiatorID {
+		who |= partyInitiator
+	}
+	if actor.ModeratorOf(proposal.CityID) {
+		who |= partyModerator
+	}
+	if actor.AddresseeOf(proposal.CityID, proposal.AddressToID) {
+		who |= partyAddressee
+	}
+	return who
+}
+
+func proposalModel(p dbx.Proposal) models.Proposal {
+	m := models.Proposal{
+		ID:           p.ID,
+		CityID:       p.CityID,
+		Title:        p.Title,
+		Description:  p.Description,
+		Status:       p.Status,
+		InitiatorID:  p.InitiatorID,
+		AddressToID:  p.AddressToID,
+		AgreedNum:    p.AgreedNum,
+		DisagreedNum: p.DisagreedNum,
+		EndDate:      p.EndDate,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+		Location:     locationModel(p.Lat, p.Lng),
+		Rank:         p.Rank,
+		Snippet:      p.Snippet,
+	}
+	if p.DecidedBy != nil && p.DecidedAt != nil {
+		m.Verdict = &models.ProposalVerdict{
+			DecidedBy: *p.DecidedBy,
+			DecidedAt: *p.DecidedAt,
+		}
+		if p.FinalAgreed != nil {
+			m.Verdict.AgreedNum = *p.FinalAgreed
+		}
+		if p.FinalDisagreed != nil {
+			m.Verdict.DisagreedNum = *p.FinalDisagreed
+		}
+	}
+	return m
+}
+
+func proposalVoteModel(v dbx.ProposalVote) models.ProposalVote {
+	return models.ProposalVote{
+		ID:         v.ID,
+		ProposalID: v.ProposalID,
+		UserID:     v.UserID,
+		Vote:       v.Vote,
+		CreatedAt:  v.CreatedAt,
+	}
+}
@@ -0,0 +1,148 @@
+// Package live fans out fresh snapshots of frequently changing rows to in-process subscribers.
+//
+// Change notifications only mark a key dirty; a flush loop reloads every dirty key that has subscribers once
+// per interval and delivers the snapshot to all of them. N watchers of the same poll therefore cost one query
+// per interval, and a burst of votes collapses into a single update.
+package live
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Loader reads the current snapshot for a key.
+type Loader[T any] func(ctx context.Context, id uuid.UUID) (T, error)
+
+type Hub[T any] struct {
+	name string
+	load Loader[T]
+
+	mu    sync.Mutex
+	subs  map[uuid.UUID]map[chan T]struct{}
+	last  map[uuid.UUID]T // последний разосланный снимок — новый подписчик получает его сразу
+	dirty map[uuid.UUID]struct{}
+}
+
+func NewHub[T any](name string, load Loader[T]) *Hub[T] {
+	return &Hub[T]{
+		name:  name,
+		load:  load,
+		subs:  make(map[uuid.UUID]map[chan T]struct{}),
+		last:  make(map[uuid.UUID]T),
+		dirty: make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving snapshots for the key and a function that ends the subscription.
+// The channel holds only the latest snapshot: a slow reader skips intermediate ones instead of blocking others.
+func (h *Hub[T]) Subscribe(id uuid.UUID) (<-chan T, func()) {
+	ch := make(chan T, 1)
+
+	h.mu.Lock()
+	if h.subs[id] == nil {
+		h.subs[id] = make(map[chan T]struct{})
+	}
+	h.subs[id][ch] = struct{}{}
+	if snapshot, ok := h.last[id]; ok {
+		ch <- snapshot
+	} else {
+		h.dirty[id] = struct{}{}
+	}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() { h.unsubscribe(id, ch) })
+	}
+}
+
+func (h *Hub[T]) unsubscribe(id uuid.UUID, ch chan T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subs[id], ch)
+	if len(h.subs[id]) == 0 {
+		delete(h.subs, id)
+		delete(h.last, id)
+		delete(h.dirty, id)
+	}
+}
+
+// Notify marks the key changed; keys without subscribers are ignored.
+func (h *Hub[T]) Notify(id uuid.UUID) {
+	h.mu.Lock()
+	if _, ok := h.subs[id]; ok {
+		h.dirty[id] = struct{}{}
+	}
+	h.mu.Unlock()
+}
+
+// NotifyAll marks every watched key changed, e.g. after the change source reconnected and may have missed events.
+func (h *Hub[T]) NotifyAll() {
+	h.mu.Lock()
+	for id := range h.subs {
+		h.dirty[id] = struct{}{}
+	}
+	h.mu.Unlock()
+}
+
+// Run flushes dirty keys every interval until the context is done.
+func (h *Hub[T]) Run(ctx context.Context, interval time.Duration, log *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.flush(ctx, log)
+		}
+	}
+}
+
+func (h *Hub[T]) flush(ctx context.Context, log *logrus.Logger) {
+	h.mu.Lock()
+	ids := make([]uuid.UUID, 0, len(h.dirty))
+	for id := range h.dirty {
+		ids = append(ids, id)
+	}
+	h.dirty = make(map[uuid.UUID]struct{})
+	h.mu.Unlock()
+
+	for _, id := range ids {
+		snapshot, err := h.load(ctx, id)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.WithError(err).WithField("id", id).Errorf("failed to load %s snapshot", h.name)
+			}
+			// попробуем ещё раз на следующем тике
+			h.Notify(id)
+			continue
+		}
+		h.publish(id, snapshot)
+	}
+}
+
+func (h *Hub[T]) publish(id uuid.UUID, snapshot T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subs[id]
+	if !ok {
+		return
+	}
+	h.last[id] = snapshot
+	for ch := range subs {
+		// в канале не больше одного снимка: устаревший вытесняется свежим
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- snapshot
+	}
+}
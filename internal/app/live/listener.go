@@ -0,0 +1,75 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	minReconnectInterval = time.Second
+	maxReconnectInterval = 30 * time.Second
+
+	// pingInterval — как часто проверять соединение, если уведомлений давно не было.
+	pingInterval = 90 * time.Second
+)
+
+// Listen relays Postgres notifications carrying a row ID to the handler of their channel until ctx is done.
+// After a reconnect notifications may have been lost, so onReconnect is called to resynchronise.
+func Listen(
+	ctx context.Context,
+	dbURL string,
+	log *logrus.Logger,
+	handlers map[string]func(id uuid.UUID),
+	onReconnect func(),
+) error {
+	listener := pq.NewListener(dbURL, minReconnectInterval, maxReconnectInterval, func(ev pq.ListenerEventType, err error) {
+		switch ev {
+		case pq.ListenerEventDisconnected:
+			log.WithError(err).Warn("live listener disconnected")
+		case pq.ListenerEventConnectionAttemptFailed:
+			log.WithError(err).Warn("live listener failed to reconnect")
+		}
+	})
+	defer listener.Close()
+
+	for channel := range handlers {
+		if err := listener.Listen(channel); err != nil {
+			return fmt.Errorf("listening to %s: %w", channel, err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case n := <-listener.Notify:
+			// nil приходит после переподключения: события за время разрыва потеряны
+			if n == nil {
+				onReconnect()
+				continue
+			}
+
+			handle, ok := handlers[n.Channel]
+			if !ok {
+				continue
+			}
+			id, err := uuid.Parse(n.Extra)
+			if err != nil {
+				log.WithError(err).Warnf("bad payload %q on channel %s", n.Extra, n.Channel)
+				continue
+			}
+			handle(id)
+
+		case <-time.After(pingInterval):
+			if err := listener.Ping(); err != nil {
+				log.WithError(err).Warn("live listener ping failed")
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/live"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/chains-lab/voting-svc/internal/dbx"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// RunLive feeds the live tallies: it listens for counter changes in Postgres and every interval delivers
+// one fresh snapshot per changed poll or proposal to all its watchers. Blocks until ctx is done.
+func (a App) RunLive(ctx context.Context, interval time.Duration, log *logrus.Logger) error {
+	go a.pollTallies.Run(ctx, interval, log)
+	go a.proposalTallies.Run(ctx, interval, log)
+
+	handlers := map[string]func(uuid.UUID){
+		dbx.PollTallyChannel:     a.pollTallies.Notify,
+		dbx.ProposalTallyChannel: a.proposalTallies.Notify,
+	}
+	return live.Listen(ctx, a.dbURL, log, handlers, func() {
+		a.pollTallies.NotifyAll()
+		a.proposalTallies.NotifyAll()
+	})
+}
+
+// Счётчики видны только у опубликованных и завершённых сущностей; до модерации, после отклонения
+// или снятия их для стрима как будто нет.
+var (
+	livePollStatuses = map[string]bool{
+		models.PollStatusPublished: true,
+		models.PollStatusClosed:    true,
+	}
+	liveProposalStatuses = map[string]bool{
+		models.ProposalStatusPublished: true,
+		models.ProposalStatusApproved:  true,
+		models.ProposalStatusRejected:  true,
+		models.ProposalStatusClosed:    true,
+	}
+)
+
+// WatchPollTally subscribes to live vote counters of a published or closed poll; other polls are NotFound.
+// The first snapshot arrives within one interval; the returned function must be called to unsubscribe.
+func (a App) WatchPollTally(ctx context.Context, id uuid.UUID) (<-chan models.PollTally, func(), error) {
+	poll, err := a.polls.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !livePollStatuses[poll.Status] {
+		return nil, nil, fmt.Errorf("poll %s: %w", id, entities.ErrNotFound)
+	}
+
+	updates, cancel := a.pollTallies.Subscribe(id)
+	return updates, cancel, nil
+}
+
+// WatchProposalTally subscribes to live vote counters of a published or decided proposal, see WatchPollTally.
+func (a App) WatchProposalTally(ctx context.Context, id uuid.UUID) (<-chan models.ProposalTally, func(), error) {
+	proposal, err := a.proposals.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !liveProposalStatuses[proposal.Status] {
+		return nil, nil, fmt.Errorf("proposal %s: %w", id, entities.ErrNotFound)
+	}
+
+	updates, cancel := a.proposalTallies.Subscribe(id)
+	return updates, cancel, nil
+}
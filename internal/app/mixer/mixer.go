@@ -0,0 +1,121 @@
+// Package mixer writes items in shuffled batches, detached from the request that submitted them.
+//
+// It exists for secret ballots: a ballot written in the voter's own transaction shares xmin, commit
+// order and physical placement with the voter's participation row, which is enough to join the two.
+// The mixer holds ballots until a batch fills up or the delay runs out and writes the whole batch
+// in one transaction of its own, in random order.
+package mixer
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// Flush writes one batch; it must write the items in the order given.
+type Flush[T any] func(ctx context.Context, batch []T) error
+
+// flushTimeout — батч не привязан ни к одному запросу, поэтому у записи свой таймаут.
+const flushTimeout = 10 * time.Second
+
+type Mixer[T any] struct {
+	flush Flush[T]
+	size  int
+	delay time.Duration
+
+	// shuffle переставляет батч перед записью; подменяется только в тестах
+	shuffle func(n int, swap func(i, j int))
+
+	mu      sync.Mutex
+	pending []entry[T]
+	timer   *time.Timer
+}
+
+type entry[T any] struct {
+	item T
+	done chan error
+}
+
+// New returns a mixer that flushes as soon as size items are pending, or delay after the first of them arrived.
+func New[T any](flush Flush[T], size int, delay time.Duration) *Mixer[T] {
+	return &Mixer[T]{
+		flush:   flush,
+		size:    size,
+		delay:   delay,
+		shuffle: rand.Shuffle,
+	}
+}
+
+// Submit queues the item and blocks until the batch holding it is written, at most delay plus the write itself.
+// It deliberately takes no context: an item cannot be taken back once queued, so the caller has to learn
+// the outcome even if its request is gone.
+func (m *Mixer[T]) Submit(item T) error {
+	done := make(chan error, 1)
+
+	m.mu.Lock()
+	m.pending = append(m.pending, entry[T]{item: item, done: done})
+	switch {
+	case len(m.pending) >= m.size:
+		batch := m.take()
+		m.mu.Unlock()
+		m.write(batch)
+	case len(m.pending) == 1:
+		m.timer = time.AfterFunc(m.delay, m.flushPending)
+		m.mu.Unlock()
+	default:
+		m.mu.Unlock()
+	}
+
+	return <-done
+}
+
+func (m *Mixer[T]) flushPending() {
+	m.mu.Lock()
+	batch := m.take()
+	m.mu.Unlock()
+	m.write(batch)
+}
+
+// take забирает накопленный батч; вызывается под m.mu.
+func (m *Mixer[T]) take() []entry[T] {
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+	batch := m.pending
+	m.pending = nil
+	return batch
+}
+
+// write пишет батч целиком; если это не удалось, каждый элемент пишется отдельно,
+// чтобы один плохой элемент не отменил чужие.
+func (m *Mixer[T]) write(batch []entry[T]) {
+	if len(batch) == 0 {
+		return
+	}
+	m.shuffle(len(batch), func(i, j int) { batch[i], batch[j] = batch[j], batch[i] })
+
+	items := make([]T, len(batch))
+	for i, e := range batch {
+		items[i] = e.item
+	}
+
+	err := m.run(items)
+	if err == nil || len(batch) == 1 {
+		for _, e := range batch {
+			e.done <- err
+		}
+		return
+	}
+
+	for _, e := range batch {
+		e.done <- m.run([]T{e.item})
+	}
+}
+
+func (m *Mixer[T]) run(items []T) error {
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	return m.flush(ctx, items)
+}
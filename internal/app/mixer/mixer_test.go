@@ -0,0 +1,160 @@
+package mixer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder собирает записанные батчи.
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]int
+	fail    func(batch []int) error
+}
+
+func (r *recorder) flush(_ context.Context, batch []int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fail != nil {
+		if err := r.fail(batch); err != nil {
+			return err
+		}
+	}
+	r.batches = append(r.batches, slices.Clone(batch))
+	return nil
+}
+
+func submitAll(m *Mixer[int], items []int) []error {
+	errs := make([]error, len(items))
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = m.Submit(item)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestFullBatchIsWrittenOnceShuffled(t *testing.T) {
+	rec := &recorder{}
+	m := New(rec.flush, 4, time.Hour)
+	m.shuffle = func(n int, swap func(i, j int)) {
+		for i := 0; i < n/2; i++ {
+			swap(i, n-1-i)
+		}
+	}
+
+	// подаём по одному, чтобы порядок поступления был известен
+	done := make(chan error, 4)
+	for i := 1; i <= 4; i++ {
+		go func() { done <- m.Submit(i) }()
+		waitPending(t, m, i%4)
+	}
+	for i := 0; i < 4; i++ {
+		if err := <-done; err != nil {
+			t.Fatalf("Submit: %v", err)
+		}
+	}
+
+	if len(rec.batches) != 1 {
+		t.Fatalf("want 1 batch, got %d", len(rec.batches))
+	}
+	if got, want := rec.batches[0], []int{4, 3, 2, 1}; !slices.Equal(got, want) {
+		t.Fatalf("batch is written in arrival order or not shuffled: got %v, want %v", got, want)
+	}
+}
+
+func TestDefaultShuffleBreaksArrivalOrder(t *testing.T) {
+	const n = 64
+	rec := &recorder{}
+	m := New(rec.flush, n, time.Hour)
+
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	for _, err := range submitAll(m, items) {
+		if err != nil {
+			t.Fatalf("Submit: %v", err)
+		}
+	}
+
+	got := rec.batches[0]
+	sorted := slices.Sorted(slices.Values(got))
+	if !slices.Equal(sorted, items) {
+		t.Fatalf("batch lost or duplicated items: %v", got)
+	}
+	if slices.IsSorted(got) {
+		t.Fatalf("batch kept the sorted order, shuffle was not applied")
+	}
+}
+
+func TestPartialBatchIsWrittenAfterDelay(t *testing.T) {
+	rec := &recorder{}
+	m := New(rec.flush, 100, 20*time.Millisecond)
+
+	start := time.Now()
+	for _, err := range submitAll(m, []int{1, 2, 3}) {
+		if err != nil {
+			t.Fatalf("Submit: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Fatalf("batch written after %s, before the delay", elapsed)
+	}
+
+	var total int
+	for _, b := range rec.batches {
+		total += len(b)
+	}
+	if total != 3 {
+		t.Fatalf("want 3 items written, got %d in %v", total, rec.batches)
+	}
+}
+
+func TestFailingItemDoesNotCancelOthers(t *testing.T) {
+	errBad := errors.New("bad item")
+	rec := &recorder{fail: func(batch []int) error {
+		if slices.Contains(batch, 2) {
+			return errBad
+		}
+		return nil
+	}}
+	m := New(rec.flush, 3, time.Hour)
+
+	errs := submitAll(m, []int{1, 2, 3})
+	for i, item := range []int{1, 2, 3} {
+		if item == 2 {
+			if !errors.Is(errs[i], errBad) {
+				t.Fatalf("item 2: want errBad, got %v", errs[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Fatalf("item %d: %v", item, errs[i])
+		}
+	}
+}
+
+// waitPending ждёт, пока в миксере накопится n элементов (0 — батч уже забран).
+func waitPending(t *testing.T, m *Mixer[int], n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		m.mu.Lock()
+		got := len(m.pending)
+		m.mu.Unlock()
+		if got == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("mixer did not reach %d pending items", n)
+}
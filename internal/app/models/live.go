@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PollTally is a live snapshot of the vote counters of a poll.
+type PollTally struct {
+	PollID    uuid.UUID
+	Options   []PollOption // ordered by VotesCount, most voted first
+	UpdatedAt time.Time
+}
+
+// ProposalTally is a live snapshot of the vote counters of a proposal.
+type ProposalTally struct {
+	ProposalID   uuid.UUID
+	AgreedNum    int
+	DisagreedNum int
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Values of the moderation_action enum.
+const (
+	ModerationPublish         = "publish"
+	ModerationDecline         = "decline"
+	ModerationWithdraw        = "withdraw"
+	ModerationEdit            = "edit"
+	ModerationDeleteSignature = "delete_signature"
+	ModerationDeleteVote      = "delete_vote"
+)
+
+// Kinds of entities a moderation action can target.
+const (
+	EntityPetition = "petition"
+	EntityPoll     = "poll"
+	EntityProposal = "proposal"
+)
+
+// ModerationEntry is one action of a moderator, kept for accountability.
+type ModerationEntry struct {
+	ID           uuid.UUID
+	ModeratorID  uuid.UUID
+	EntityType   string
+	EntityID     uuid.UUID
+	Action       string
+	Reason       *string
+	TargetUserID *uuid.UUID // author of the removed signature or vote
+	CreatedAt    time.Time
+}
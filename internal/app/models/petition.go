@@ -6,6 +6,19 @@ import (
 	"github.com/google/uuid"
 )
 
+// Values of the petition_status enum.
+const (
+	PetitionStatusProcessed = "processed"
+	PetitionStatusDeclined  = "declined"
+	PetitionStatusPublished = "published"
+	PetitionStatusWithdrawn = "withdrawn"
+	PetitionStatusApproved  = "approved"
+	PetitionStatusRejected  = "rejected"
+	PetitionStatusExpired   = "expired"
+	// goal reached, collecting is over and the addressee is expected to answer
+	PetitionStatusAwaitingResponse = "awaiting_response"
+)
+
 type Petition struct {
 	ID          uuid.UUID
 	CityID      uuid.UUID
@@ -16,8 +29,14 @@ type Petition struct {
 	Status      string
 	Signatures  int
 	Goal        int
-	EndDate     time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	Location    *Location
+	StopAtGoal  bool
+	// GoalReachedAt is the moment signatures first reached Goal, nil while the goal is not reached.
+	GoalReachedAt *time.Time
+	EndDate       time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Location      *Location
+	// Rank and Snippet (matches highlighted with <b>) are set only in full-text search results.
+	Rank    float32
+	Snippet string
 }
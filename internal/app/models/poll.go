@@ -6,16 +6,41 @@ import (
 	"github.com/google/uuid"
 )
 
+// Values of the poll_status enum.
+const (
+	PollStatusProcessed = "processed"
+	PollStatusDeclined  = "declined"
+	PollStatusPublished = "published"
+	PollStatusWithdrawn = "withdrawn"
+	PollStatusClosed    = "closed"
+)
+
+// Values of the poll_type enum.
+const (
+	PollTypeChoice   = "choice"
+	PollTypeRanked   = "ranked"
+	PollTypeApproval = "approval"
+	PollTypeScore    = "score"
+)
+
 type Poll struct {
 	ID          uuid.UUID
 	CityID      uuid.UUID
 	Title       string
 	Description string
 	Status      string
+	Type        string
+	Secret      bool // votes are stored without linkage to the voter
 	InitiatorID uuid.UUID
+	MinChoices  int // minimum number of options a voter must pick (or rank)
+	MaxChoices  int // maximum number of options a voter may pick (or rank)
+	MaxScore    int // highest score an option may get in a score poll
 	Options     []PollOption
 	EndDate     time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	Location    *Location
+	// Rank and Snippet (matches highlighted with <b>) are set only in full-text search results.
+	Rank    float32
+	Snippet string
 }
@@ -11,5 +11,6 @@ type PollOption struct {
 	PollID     uuid.UUID
 	OptionText string
 	VotesCount int
+	Position   int // 0-based place of the option in the poll
 	CreatedAt  time.Time
 }
@@ -0,0 +1,54 @@
+package models
+
+import "github.com/google/uuid"
+
+// PollResults are the current results of a poll.
+type PollResults struct {
+	PollID    uuid.UUID
+	Type      string
+	Options   []PollOption // ordered by VotesCount, most voted first
+	Runoff    *RunoffResult
+	Schulze   *SchulzeResult
+	Approvals []OptionTally // approval polls: approvals per option
+	Scores    []OptionScore // score polls: statistics per option
+}
+
+type OptionScore struct {
+	OptionID     uuid.UUID
+	Ratings      int     // ballots that scored the option
+	MeanScore    float64 // 0 when the option has no ratings
+	Distribution []int   // Distribution[s] — ballots that gave the option score s, s in 0..MaxScore
+}
+
+// RunoffResult is the instant-runoff report of a ranked poll.
+type RunoffResult struct {
+	Ballots int
+	Rounds  []RunoffRound
+	Winner  *uuid.UUID
+	Tied    []uuid.UUID // remaining options tied in every round, so that only list order could pick the winner
+}
+
+type RunoffRound struct {
+	Number     int
+	Tally      []OptionTally // ordered by votes, most voted first
+	Exhausted  int           // ballots that rank none of the remaining options
+	Eliminated []uuid.UUID   // at most one option per round
+}
+
+type OptionTally struct {
+	OptionID uuid.UUID
+	Votes    int
+}
+
+// SchulzeResult is the pairwise-comparison report of a ranked poll.
+// Matrices are indexed by the position of the option in Options.
+type SchulzeResult struct {
+	Options   []uuid.UUID
+	Pairwise  [][]int // Pairwise[i][j] — ballots preferring Options[i] over Options[j]
+	Strongest [][]int // Strongest[i][j] — strength of the strongest path from Options[i] to Options[j]
+
+	Winners         []uuid.UUID   // every option no other option beats on strongest paths; more than one on a tie
+	Ranking         [][]uuid.UUID // Winners first, then the unbeaten options among the rest, and so on
+	CondorcetWinner *uuid.UUID    // option beating every other one head-to-head, if any
+	Cycles          [][]uuid.UUID // groups of options that beat each other in a majority cycle
+}
@@ -9,7 +9,16 @@ import (
 type PollVote struct {
 	ID        uuid.UUID
 	PollID    uuid.UUID
-	UserID    uuid.UUID
+	BallotID  uuid.UUID
+	UserID    *uuid.UUID // nil for votes in secret polls
 	OptionID  uuid.UUID
+	Rank      *int // position on the ballot of a ranked poll, 1 is the most preferred
+	Score     *int // score given to the option in a score poll
 	CreatedAt time.Time
 }
+
+// PollChoice is one entry of a ballot being cast: the option and, for score polls, the score given to it.
+type PollChoice struct {
+	OptionID uuid.UUID
+	Score    *int
+}
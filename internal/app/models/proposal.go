@@ -6,6 +6,17 @@ import (
 	"github.com/google/uuid"
 )
 
+// Values of the proposal_status enum.
+const (
+	ProposalStatusProcessed = "processed"
+	ProposalStatusDeclined  = "declined"
+	ProposalStatusPublished = "published"
+	ProposalStatusWithdrawn = "withdrawn"
+	ProposalStatusApproved  = "approved"
+	ProposalStatusRejected  = "rejected"
+	ProposalStatusClosed    = "closed"
+)
+
 type Proposal struct {
 	ID           uuid.UUID
 	CityID       uuid.UUID
@@ -20,4 +31,16 @@ type Proposal struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	Location     *Location
+	Verdict      *ProposalVerdict
+	// Rank and Snippet (matches highlighted with <b>) are set only in full-text search results.
+	Rank    float32
+	Snippet string
+}
+
+// ProposalVerdict is the final decision of the addressee together with the tally it was made on.
+type ProposalVerdict struct {
+	DecidedBy    uuid.UUID
+	DecidedAt    time.Time
+	AgreedNum    int
+	DisagreedNum int
 }
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/google/uuid"
+)
+
+func (a App) PetitionsQueue(ctx context.Context, actor entities.Actor, cityID uuid.UUID, page entities.PageRequest) ([]models.Petition, uint64, string, error) {
+	return a.petitions.Queue(ctx, actor, cityID, page)
+}
+
+func (a App) ModeratePetition(ctx context.Context, actor entities.Actor, id uuid.UUID, status, reason string) (models.Petition, error) {
+	return a.petitions.Moderate(ctx, actor, id, status, reason)
+}
+
+func (a App) EditPetition(ctx context.Context, actor entities.Actor, id uuid.UUID, edit entities.ContentEdit, reason string) (models.Petition, error) {
+	return a.petitions.Edit(ctx, actor, id, edit, reason)
+}
+
+func (a App) RemovePetitionSignature(ctx context.Context, actor entities.Actor, id, userID uuid.UUID, reason string) (models.Petition, error) {
+	return a.petitions.RemoveSignature(ctx, actor, id, userID, reason)
+}
+
+func (a App) PollsQueue(ctx context.Context, actor entities.Actor, cityID uuid.UUID, page entities.PageRequest) ([]models.Poll, uint64, string, error) {
+	return a.polls.Queue(ctx, actor, cityID, page)
+}
+
+func (a App) ModeratePoll(ctx context.Context, actor entities.Actor, id uuid.UUID, status, reason string) (models.Poll, error) {
+	return a.polls.Moderate(ctx, actor, id, status, reason)
+}
+
+func (a App) EditPoll(ctx context.Context, actor entities.Actor, id uuid.UUID, edit entities.ContentEdit, reason string) (models.Poll, error) {
+	return a.polls.Edit(ctx, actor, id, edit, reason)
+}
+
+func (a App) RemovePollVotes(ctx context.Context, actor entities.Actor, id, userID uuid.UUID, reason string) (models.Poll, error) {
+	return a.polls.RemoveVotes(ctx, actor, id, userID, reason)
+}
+
+func (a App) ProposalsQueue(ctx context.Context, actor entities.Actor, cityID uuid.UUID, page entities.PageRequest) ([]models.Proposal, uint64, string, error) {
+	return a.proposals.Queue(ctx, actor, cityID, page)
+}
+
+func (a App) ModerateProposal(ctx context.Context, actor entities.Actor, id uuid.UUID, status, reason string) (models.Proposal, error) {
+	return a.proposals.Moderate(ctx, actor, id, status, reason)
+}
+
+func (a App) EditProposal(ctx context.Context, actor entities.Actor, id uuid.UUID, edit entities.ContentEdit, reason string) (models.Proposal, error) {
+	return a.proposals.Edit(ctx, actor, id, edit, reason)
+}
+
+func (a App) RemoveProposalVote(ctx context.Context, actor entities.Actor, id, userID uuid.UUID, reason string) (models.Proposal, error) {
+	return a.proposals.RemoveVote(ctx, actor, id, userID, reason)
+}
+
+func (a App) ModerationHistory(ctx context.Context, actor entities.Actor, entityID uuid.UUID, page entities.PageRequest) ([]models.ModerationEntry, uint64, string, error) {
+	return a.moderation.History(ctx, actor, entityID, page)
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/google/uuid"
+)
+
+func (a App) CreatePetition(ctx context.Context, actor entities.Actor, in entities.CreatePetitionInput) (models.Petition, error) {
+	return a.petitions.Create(ctx, actor, in)
+}
+
+func (a App) GetPetition(ctx context.Context, id uuid.UUID) (models.Petition, error) {
+	return a.petitions.Get(ctx, id)
+}
+
+func (a App) ListPetitions(ctx context.Context, filter entities.ListFilter, page entities.PageRequest) ([]models.Petition, uint64, string, error) {
+	return a.petitions.List(ctx, filter, page)
+}
+
+func (a App) SignPetition(ctx context.Context, actor entities.Actor, id uuid.UUID) (models.Petition, error) {
+	return a.petitions.Sign(ctx, actor, id)
+}
+
+func (a App) UnsignPetition(ctx context.Context, actor entities.Actor, id uuid.UUID) (models.Petition, error) {
+	return a.petitions.Unsign(ctx, actor, id)
+}
+
+func (a App) PetitionSignature(ctx context.Context, actor entities.Actor, id uuid.UUID) (models.PetitionSignature, error) {
+	return a.petitions.Signature(ctx, actor, id)
+}
+
+func (a App) WithdrawPetition(ctx context.Context, actor entities.Actor, id uuid.UUID) (models.Petition, error) {
+	return a.petitions.Withdraw(ctx, actor, id)
+}
+
+func (a App) AnswerPetition(ctx context.Context, actor entities.Actor, id uuid.UUID, approve bool) (models.Petition, error) {
+	if approve {
+		return a.petitions.Approve(ctx, actor, id)
+	}
+	return a.petitions.Reject(ctx, actor, id)
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/google/uuid"
+)
+
+func (a App) CreatePoll(ctx context.Context, actor entities.Actor, in entities.CreatePollInput) (models.Poll, error) {
+	return a.polls.Create(ctx, actor, in)
+}
+
+func (a App) GetPoll(ctx context.Context, id uuid.UUID) (models.Poll, error) {
+	return a.polls.Get(ctx, id)
+}
+
+func (a App) ListPolls(ctx context.Context, filter entities.ListFilter, page entities.PageRequest) ([]models.Poll, uint64, string, error) {
+	return a.polls.List(ctx, filter, page)
+}
+
+func (a App) VotePoll(ctx context.Context, actor entities.Actor, id uuid.UUID, choices []models.PollChoice) ([]models.PollVote, error) {
+	return a.polls.Vote(ctx, actor, id, choices)
+}
+
+func (a App) RetractPollVote(ctx context.Context, actor entities.Actor, id uuid.UUID) error {
+	return a.polls.RetractVote(ctx, actor, id)
+}
+
+// PollVote reports whether the actor has voted in the poll and returns the selection, which is empty
+// for secret polls.
+func (a App) PollVote(ctx context.Context, actor entities.Actor, id uuid.UUID) (bool, []models.PollVote, error) {
+	voted, err := a.polls.HasVoted(ctx, actor, id)
+	if err != nil || !voted {
+		return false, nil, err
+	}
+
+	votes, err := a.polls.UserVotes(ctx, actor, id)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, votes, nil
+}
+
+func (a App) PollResults(ctx context.Context, id uuid.UUID) (models.PollResults, error) {
+	return a.polls.Results(ctx, id)
+}
+
+func (a App) WithdrawPoll(ctx context.Context, actor entities.Actor, id uuid.UUID) (models.Poll, error) {
+	return a.polls.ChangeStatus(ctx, actor, id, models.PollStatusWithdrawn)
+}
+
+func (a App) AddPollOption(ctx context.Context, actor entities.Actor, id uuid.UUID, text string) (models.Poll, error) {
+	return a.polls.AddOption(ctx, actor, id, text)
+}
+
+func (a App) EditPollOption(ctx context.Context, actor entities.Actor, id, optionID uuid.UUID, text string) (models.Poll, error) {
+	return a.polls.EditOption(ctx, actor, id, optionID, text)
+}
+
+func (a App) RemovePollOption(ctx context.Context, actor entities.Actor, id, optionID uuid.UUID) (models.Poll, error) {
+	return a.polls.RemoveOption(ctx, actor, id, optionID)
+}
+
+func (a App) ReorderPollOptions(ctx context.Context, actor entities.Actor, id uuid.UUID, optionIDs []uuid.UUID) (models.Poll, error) {
+	return a.polls.ReorderOptions(ctx, actor, id, optionIDs)
+}
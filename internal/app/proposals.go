@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+
+	"github.com/chains-lab/voting-svc/internal/app/entities"
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/google/uuid"
+)
+
+func (a App) CreateProposal(ctx context.Context, actor entities.Actor, in entities.CreateProposalInput) (models.Proposal, error) {
+	return a.proposals.Create(ctx, actor, in)
+}
+
+func (a App) GetProposal(ctx context.Context, id uuid.UUID) (models.Proposal, error) {
+	return a.proposals.Get(ctx, id)
+}
+
+func (a App) ListProposals(ctx context.Context, filter entities.ListFilter, page entities.PageRequest) ([]models.Proposal, uint64, string, error) {
+	return a.proposals.List(ctx, filter, page)
+}
+
+func (a App) VoteProposal(ctx context.Context, actor entities.Actor, id uuid.UUID, agree bool) (models.ProposalVote, error) {
+	return a.proposals.Vote(ctx, actor, id, agree)
+}
+
+func (a App) RetractProposalVote(ctx context.Context, actor entities.Actor, id uuid.UUID) error {
+	return a.proposals.RetractVote(ctx, actor, id)
+}
+
+func (a App) ProposalVote(ctx context.Context, actor entities.Actor, id uuid.UUID) (models.ProposalVote, error) {
+	return a.proposals.UserVote(ctx, actor, id)
+}
+
+func (a App) WithdrawProposal(ctx context.Context, actor entities.Actor, id uuid.UUID) (models.Proposal, error) {
+	return a.proposals.ChangeStatus(ctx, actor, id, models.ProposalStatusWithdrawn)
+}
+
+func (a App) AnswerProposal(ctx context.Context, actor entities.Actor, id uuid.UUID, approve bool) (models.Proposal, error) {
+	return a.proposals.Decide(ctx, actor, id, approve)
+}
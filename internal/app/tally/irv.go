@@ -0,0 +1,133 @@
+package tally
+
+import (
+	"sort"
+
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/google/uuid"
+)
+
+// Ballot lists option IDs in order of preference, the most preferred first.
+type Ballot []uuid.UUID
+
+// InstantRunoff tallies ranked ballots round by round. Each round every ballot counts for its most preferred
+// option still in the race; an option backed by a strict majority of non-exhausted ballots wins, otherwise
+// exactly one option with the fewest votes is eliminated.
+//
+// Ties for the fewest votes are broken by the earlier rounds: the option with fewer votes in the previous round
+// goes, and if that is equal too, the round before it and so on back to the first one. If the options are
+// tied in every round, the one listed last in options goes. When all remaining options are tied and no earlier
+// round tells them apart, the winner would be decided by list order alone, so the result has no winner and
+// the tied options are reported instead.
+func InstantRunoff(options []uuid.UUID, ballots []Ballot) models.RunoffResult {
+	result := models.RunoffResult{Ballots: len(ballots)}
+
+	remaining := make(map[uuid.UUID]bool, len(options))
+	position := make(map[uuid.UUID]int, len(options))
+	for i, o := range options {
+		remaining[o] = true
+		position[o] = i
+	}
+
+	var history []map[uuid.UUID]int // счёт каждого раунда, для разрешения ничьих
+	for number := 1; len(remaining) > 0; number++ {
+		counts := make(map[uuid.UUID]int, len(remaining))
+		exhausted := 0
+		for _, b := range ballots {
+			top, ok := firstRemaining(b, remaining)
+			if !ok {
+				exhausted++
+				continue
+			}
+			counts[top]++
+		}
+		history = append(history, counts)
+
+		round := models.RunoffRound{
+			Number:    number,
+			Tally:     sortedTally(options, remaining, counts),
+			Exhausted: exhausted,
+		}
+
+		leader := round.Tally[0]
+		if leader.Votes*2 > len(ballots)-exhausted || len(remaining) == 1 {
+			result.Rounds = append(result.Rounds, round)
+			winner := leader.OptionID
+			result.Winner = &winner
+			return result
+		}
+
+		fewest := round.Tally[len(round.Tally)-1].Votes
+		var lowest []uuid.UUID
+		for _, t := range round.Tally {
+			if t.Votes == fewest {
+				lowest = append(lowest, t.OptionID)
+			}
+		}
+
+		out, byPosition := eliminationLoser(lowest, history, position)
+		result.Rounds = append(result.Rounds, round)
+		if byPosition && len(lowest) == len(remaining) {
+			result.Tied = lowest
+			return result
+		}
+
+		result.Rounds[len(result.Rounds)-1].Eliminated = []uuid.UUID{out}
+		delete(remaining, out)
+	}
+
+	return result
+}
+
+// eliminationLoser выбирает, кто из набравших меньше всех выбывает: сравниваются счета предыдущих раундов
+// от последнего к первому, а если они везде равны — выбывает последний в options (byPosition = true).
+func eliminationLoser(lowest []uuid.UUID, history []map[uuid.UUID]int, position map[uuid.UUID]int) (uuid.UUID, bool) {
+	candidates := lowest
+	for r := len(history) - 2; r >= 0 && len(candidates) > 1; r-- {
+		fewest := -1
+		for _, o := range candidates {
+			if v := history[r][o]; fewest < 0 || v < fewest {
+				fewest = v
+			}
+		}
+		var next []uuid.UUID
+		for _, o := range candidates {
+			if history[r][o] == fewest {
+				next = append(next, o)
+			}
+		}
+		candidates = next
+	}
+	if len(candidates) == 1 {
+		return candidates[0], false
+	}
+
+	last := candidates[0]
+	for _, o := range candidates[1:] {
+		if position[o] > position[last] {
+			last = o
+		}
+	}
+	return last, true
+}
+
+func firstRemaining(b Ballot, remaining map[uuid.UUID]bool) (uuid.UUID, bool) {
+	for _, o := range b {
+		if remaining[o] {
+			return o, true
+		}
+	}
+	return uuid.Nil, false
+}
+
+// sortedTally — счёт по оставшимся вариантам, от большего к меньшему; при равенстве сохраняется порядок options.
+func sortedTally(options []uuid.UUID, remaining map[uuid.UUID]bool, counts map[uuid.UUID]int) []models.OptionTally {
+	out := make([]models.OptionTally, 0, len(remaining))
+	for _, o := range options {
+		if remaining[o] {
+			out = append(out, models.OptionTally{OptionID: o, Votes: counts[o]})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Votes > out[j].Votes })
+	return out
+}
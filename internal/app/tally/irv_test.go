@@ -0,0 +1,122 @@
+package tally
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInstantRunoff(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    []string
+		ballots    []Ballot
+		winner     string
+		tied       []string
+		eliminated [][]string // по раундам
+		exhausted  []int      // по раундам
+	}{
+		{
+			name: "no options",
+		},
+		{
+			name:       "majority in round 1",
+			options:    []string{"a", "b", "c"},
+			ballots:    concat(repeat(3, "a"), repeat(1, "b"), repeat(1, "c")),
+			winner:     "a",
+			eliminated: [][]string{nil},
+			exhausted:  []int{0},
+		},
+		{
+			// после выбывания c его бюллетени исчерпаны, и большинство считается от оставшихся пяти
+			name:       "majority of non-exhausted ballots",
+			options:    []string{"a", "b", "c"},
+			ballots:    concat(repeat(3, "a"), repeat(2, "b"), repeat(2, "c")),
+			winner:     "a",
+			eliminated: [][]string{{"c"}, nil},
+			exhausted:  []int{0, 2},
+		},
+		{
+			// b и c по 3 голоса во втором раунде; в первом у c было меньше — выбывает c
+			name:       "tie broken by the previous round",
+			options:    []string{"a", "b", "c", "d"},
+			ballots:    concat(repeat(4, "a"), repeat(3, "b"), repeat(2, "c", "b"), repeat(1, "d", "c", "b")),
+			winner:     "b",
+			eliminated: [][]string{{"d"}, {"c"}, nil},
+			exhausted:  []int{0, 0, 0},
+		},
+		{
+			// b и c равны и в истории нет различий — выбывает последний в списке
+			name:       "tie broken by position",
+			options:    []string{"a", "b", "c"},
+			ballots:    concat(repeat(3, "a"), repeat(2, "b", "c"), repeat(2, "c", "b")),
+			winner:     "b",
+			eliminated: [][]string{{"c"}, nil},
+			exhausted:  []int{0, 0},
+		},
+		{
+			name:       "one elimination per round",
+			options:    []string{"a", "b", "c", "d"},
+			ballots:    concat(repeat(3, "a"), repeat(1, "b", "a"), repeat(1, "c", "a"), repeat(1, "d", "a")),
+			winner:     "a",
+			eliminated: [][]string{{"d"}, nil},
+			exhausted:  []int{0, 0},
+		},
+		{
+			name:       "full tie",
+			options:    []string{"a", "b"},
+			ballots:    concat(repeat(1, "a"), repeat(1, "b")),
+			tied:       []string{"a", "b"},
+			eliminated: [][]string{nil},
+			exhausted:  []int{0},
+		},
+		{
+			name:       "full tie after exhaustion",
+			options:    []string{"a", "b", "c"},
+			ballots:    concat(repeat(2, "a"), repeat(2, "b"), repeat(1, "c")),
+			tied:       []string{"a", "b"},
+			eliminated: [][]string{{"c"}, nil},
+			exhausted:  []int{0, 1},
+		},
+		{
+			name:       "no ballots",
+			options:    []string{"a", "b"},
+			tied:       []string{"a", "b"},
+			eliminated: [][]string{nil},
+			exhausted:  []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InstantRunoff(ids(tt.options...), tt.ballots)
+
+			if got.Ballots != len(tt.ballots) {
+				t.Errorf("ballots = %d, want %d", got.Ballots, len(tt.ballots))
+			}
+			switch {
+			case tt.winner == "" && got.Winner != nil:
+				t.Errorf("winner = %v, want none", *got.Winner)
+			case tt.winner != "" && (got.Winner == nil || *got.Winner != testOptions[tt.winner]):
+				t.Errorf("winner = %v, want %s", got.Winner, tt.winner)
+			}
+			if want := ids(tt.tied...); !reflect.DeepEqual(got.Tied, want) {
+				t.Errorf("tied = %v, want %v", got.Tied, want)
+			}
+
+			if len(got.Rounds) != len(tt.eliminated) {
+				t.Fatalf("got %d rounds, want %d", len(got.Rounds), len(tt.eliminated))
+			}
+			for i, r := range got.Rounds {
+				if r.Number != i+1 {
+					t.Errorf("round %d numbered %d", i+1, r.Number)
+				}
+				if want := ids(tt.eliminated[i]...); !reflect.DeepEqual(r.Eliminated, want) {
+					t.Errorf("round %d eliminated %v, want %v", i+1, r.Eliminated, want)
+				}
+				if r.Exhausted != tt.exhausted[i] {
+					t.Errorf("round %d exhausted %d, want %d", i+1, r.Exhausted, tt.exhausted[i])
+				}
+			}
+		})
+	}
+}
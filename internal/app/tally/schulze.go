@@ -0,0 +1,207 @@
+package tally
+
+import (
+	"sort"
+
+	"github.com/chains-lab/voting-svc/internal/app/models"
+	"github.com/google/uuid"
+)
+
+// Schulze builds the pairwise preference matrix of ranked ballots and applies the Schulze method to it.
+// An option ranked on a ballot is preferred to every option the ballot does not rank; options a ballot
+// leaves unranked are not compared by it.
+func Schulze(options []uuid.UUID, ballots []Ballot) models.SchulzeResult {
+	n := len(options)
+	index := make(map[uuid.UUID]int, n)
+	for i, o := range options {
+		index[o] = i
+	}
+
+	d := squareMatrix(n)
+	for _, b := range ballots {
+		ranked := make([]bool, n)
+		var order []int
+		for _, o := range b {
+			if i, ok := index[o]; ok && !ranked[i] {
+				ranked[i] = true
+				order = append(order, i)
+			}
+		}
+
+		for pos, i := range order {
+			for _, j := range order[pos+1:] {
+				d[i][j]++
+			}
+			for j := 0; j < n; j++ {
+				if !ranked[j] {
+					d[i][j]++
+				}
+			}
+		}
+	}
+
+	// сильнейшие пути — вариант Флойда–Уоршелла из описания метода Шульце
+	p := squareMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && d[i][j] > d[j][i] {
+				p[i][j] = d[i][j]
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if i == k {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if j == i || j == k {
+					continue
+				}
+				p[i][j] = max(p[i][j], min(p[i][k], p[k][j]))
+			}
+		}
+	}
+
+	result := models.SchulzeResult{
+		Options:   append([]uuid.UUID(nil), options...),
+		Pairwise:  d,
+		Strongest: p,
+		Ranking:   schulzeRanking(options, p),
+		Cycles:    majorityCycles(options, d),
+	}
+	// победители — все варианты, которых никто не побеждает: это ровно первый уровень ранжирования
+	if len(result.Ranking) > 0 {
+		result.Winners = result.Ranking[0]
+	}
+
+	for i := 0; i < n; i++ {
+		beatsAll := true
+		for j := 0; j < n; j++ {
+			if i != j && d[i][j] <= d[j][i] {
+				beatsAll = false
+				break
+			}
+		}
+		if beatsAll {
+			winner := options[i]
+			result.CondorcetWinner = &winner
+			break
+		}
+	}
+
+	return result
+}
+
+// schulzeRanking раскладывает варианты по уровням: первый — варианты, которых никто не побеждает
+// по сильнейшим путям (победители Шульце), следующий — непобеждённые среди оставшихся и т.д.
+// Отношение p[i][j] > p[j][i] транзитивно, но не полно, поэтому число побед варианта его место не определяет:
+// вариант с одной победой может быть никем не побеждён. Внутри уровня варианты идут в порядке options.
+func schulzeRanking(options []uuid.UUID, p [][]int) [][]uuid.UUID {
+	remaining := make([]int, len(options))
+	for i := range remaining {
+		remaining[i] = i
+	}
+
+	var ranking [][]uuid.UUID
+	for len(remaining) > 0 {
+		var tier []uuid.UUID
+		var rest []int
+		for _, x := range remaining {
+			if beatenWithin(x, remaining, p) {
+				rest = append(rest, x)
+			} else {
+				tier = append(tier, options[x])
+			}
+		}
+		ranking = append(ranking, tier)
+		remaining = rest
+	}
+	return ranking
+}
+
+// beatenWithin — побеждает ли x кто-то из set по сильнейшим путям. Из транзитивности отношения следует,
+// что в любом непустом set есть непобеждённый вариант, так что schulzeRanking всегда продвигается.
+func beatenWithin(x int, set []int, p [][]int) bool {
+	for _, y := range set {
+		if y != x && p[y][x] > p[x][y] {
+			return true
+		}
+	}
+	return false
+}
+
+// majorityCycles returns the strongly connected components of the majority graph (i -> j when more ballots
+// prefer i to j) that contain more than one option: exactly the groups of options caught in a Condorcet cycle.
+func majorityCycles(options []uuid.UUID, d [][]int) [][]uuid.UUID {
+	n := len(options)
+	beats := func(i, j int) bool { return i != j && d[i][j] > d[j][i] }
+
+	// алгоритм Тарьяна
+	var (
+		counter  int
+		stack    []int
+		cycles   [][]uuid.UUID
+		indexOf  = make([]int, n)
+		lowlink  = make([]int, n)
+		onStack  = make([]bool, n)
+		visited  = make([]bool, n)
+		strongly func(v int)
+	)
+	strongly = func(v int) {
+		visited[v] = true
+		indexOf[v], lowlink[v] = counter, counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for w := 0; w < n; w++ {
+			if !beats(v, w) {
+				continue
+			}
+			if !visited[w] {
+				strongly(w)
+				lowlink[v] = min(lowlink[v], lowlink[w])
+			} else if onStack[w] {
+				lowlink[v] = min(lowlink[v], indexOf[w])
+			}
+		}
+
+		if lowlink[v] != indexOf[v] {
+			return
+		}
+		var component []int
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		if len(component) > 1 {
+			sort.Ints(component)
+			cycle := make([]uuid.UUID, 0, len(component))
+			for _, i := range component {
+				cycle = append(cycle, options[i])
+			}
+			cycles = append(cycles, cycle)
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		if !visited[v] {
+			strongly(v)
+		}
+	}
+	return cycles
+}
+
+func squareMatrix(n int) [][]int {
+	m := make([][]int, n)
+	for i := range m {
+		m[i] = make([]int, n)
+	}
+	return m
+}
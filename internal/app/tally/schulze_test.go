@@ -0,0 +1,142 @@
+package tally
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// Варианты в тестах называются буквами, чтобы ожидания читались как в описании метода.
+var testOptions = map[string]uuid.UUID{
+	"a": uuid.MustParse("00000000-0000-0000-0000-00000000000a"),
+	"b": uuid.MustParse("00000000-0000-0000-0000-00000000000b"),
+	"c": uuid.MustParse("00000000-0000-0000-0000-00000000000c"),
+	"d": uuid.MustParse("00000000-0000-0000-0000-00000000000d"),
+}
+
+func ids(names ...string) []uuid.UUID {
+	if len(names) == 0 {
+		return nil
+	}
+	out := make([]uuid.UUID, 0, len(names))
+	for _, n := range names {
+		out = append(out, testOptions[n])
+	}
+	return out
+}
+
+func groups(gs ...[]string) [][]uuid.UUID {
+	if len(gs) == 0 {
+		return nil
+	}
+	out := make([][]uuid.UUID, 0, len(gs))
+	for _, g := range gs {
+		out = append(out, ids(g...))
+	}
+	return out
+}
+
+// repeat — n одинаковых бюллетеней.
+func repeat(n int, names ...string) []Ballot {
+	out := make([]Ballot, n)
+	for i := range out {
+		out[i] = ids(names...)
+	}
+	return out
+}
+
+func concat(bs ...[]Ballot) []Ballot {
+	var out []Ballot
+	for _, b := range bs {
+		out = append(out, b...)
+	}
+	return out
+}
+
+func TestSchulze(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   []string
+		ballots   []Ballot
+		winners   []string
+		ranking   [][]string
+		condorcet string
+		cycles    [][]string
+	}{
+		{
+			name: "no options",
+		},
+		{
+			name:    "no ballots, everything tied",
+			options: []string{"a", "b"},
+			winners: []string{"a", "b"},
+			ranking: [][]string{{"a", "b"}},
+		},
+		{
+			name:      "condorcet winner",
+			options:   []string{"a", "b", "c"},
+			ballots:   repeat(3, "a", "b", "c"),
+			winners:   []string{"a"},
+			ranking:   [][]string{{"a"}, {"b"}, {"c"}},
+			condorcet: "a",
+		},
+		{
+			// c никем не побеждён (p[a][c] = p[c][a] = 0), хотя побед у него нет — он тоже победитель
+			name:    "unbeaten option without wins",
+			options: []string{"a", "b", "c"},
+			ballots: concat(repeat(1, "a", "b", "c"), repeat(1, "c", "a", "b")),
+			winners: []string{"a", "c"},
+			ranking: [][]string{{"a", "c"}, {"b"}},
+		},
+		{
+			// a>b 6:3, b>c 7:2, c>a 5:4 — цикл разрешается сильнейшими путями
+			name:    "cycle resolved by strongest paths",
+			options: []string{"a", "b", "c"},
+			ballots: concat(repeat(4, "a", "b", "c"), repeat(3, "b", "c", "a"), repeat(2, "c", "a", "b")),
+			winners: []string{"a"},
+			ranking: [][]string{{"a"}, {"b"}, {"c"}},
+			cycles:  [][]string{{"a", "b", "c"}},
+		},
+		{
+			name:    "symmetric cycle is a full tie",
+			options: []string{"a", "b", "c"},
+			ballots: concat(repeat(1, "a", "b", "c"), repeat(1, "b", "c", "a"), repeat(1, "c", "a", "b")),
+			winners: []string{"a", "b", "c"},
+			ranking: [][]string{{"a", "b", "c"}},
+			cycles:  [][]string{{"a", "b", "c"}},
+		},
+		{
+			// неранжированные варианты проигрывают ранжированным, но между собой не сравниваются
+			name:      "tie below the winner",
+			options:   []string{"a", "b", "c", "d"},
+			ballots:   concat(repeat(2, "a", "b"), repeat(2, "a", "c")),
+			winners:   []string{"a"},
+			ranking:   [][]string{{"a"}, {"b", "c"}, {"d"}},
+			condorcet: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Schulze(ids(tt.options...), tt.ballots)
+
+			if want := ids(tt.winners...); !reflect.DeepEqual(got.Winners, want) {
+				t.Errorf("winners = %v, want %v", got.Winners, want)
+			}
+			if want := groups(tt.ranking...); !reflect.DeepEqual(got.Ranking, want) {
+				t.Errorf("ranking = %v, want %v", got.Ranking, want)
+			}
+			if want := groups(tt.cycles...); !reflect.DeepEqual(got.Cycles, want) {
+				t.Errorf("cycles = %v, want %v", got.Cycles, want)
+			}
+
+			switch {
+			case tt.condorcet == "" && got.CondorcetWinner != nil:
+				t.Errorf("condorcet winner = %v, want none", *got.CondorcetWinner)
+			case tt.condorcet != "" && (got.CondorcetWinner == nil || *got.CondorcetWinner != testOptions[tt.condorcet]):
+				t.Errorf("condorcet winner = %v, want %s", got.CondorcetWinner, tt.condorcet)
+			}
+		})
+	}
+}
@@ -10,11 +10,12 @@ import (
 )
 
 type ServerConfig struct {
-	Name     string `mapstructure:"name"`
-	Port     string `mapstructure:"port"`
-	BasePath string `mapstructure:"base_path"`
-	TestMode bool   `mapstructure:"test_mode"`
-	Log      struct {
+	Name       string `mapstructure:"name"`
+	Port       string `mapstructure:"port"`
+	BasePath   string `mapstructure:"base_path"`
+	TestMode   bool   `mapstructure:"test_mode"`
+	Reflection bool   `mapstructure:"reflection"` // gRPC server reflection для grpcurl, на проде выключено
+	Log        struct {
 		Level  string `mapstructure:"level"`
 		Format string `mapstructure:"format"`
 	} `mapstructure:"log"`
@@ -69,19 +70,68 @@ type RabbitConfig struct {
 }
 
 type SwaggerConfig struct {
-	Enabled bool   `mapstructure:"enabled"`
-	URL     string `mapstructure:"url"`
-	Port    string `mapstructure:"port"`
+	Enabled bool            `mapstructure:"enabled"`
+	URL     string          `mapstructure:"url"`
+	Port    string          `mapstructure:"port"`
+	UI      SwaggerUIConfig `mapstructure:"ui"`
+}
+
+// SwaggerUIConfig pins the swagger-ui-dist build the Swagger UI page loads from the CDN.
+// Without both integrity hashes the page is not served; the OpenAPI document stays available.
+type SwaggerUIConfig struct {
+	Version      string `mapstructure:"version"`       // точная версия swagger-ui-dist, пусто — версия по умолчанию
+	CSSIntegrity string `mapstructure:"css_integrity"` // SRI swagger-ui.css этой версии: sha384-…
+	JSIntegrity  string `mapstructure:"js_integrity"`  // SRI swagger-ui-bundle.js этой версии
+}
+
+type RateLimitConfig struct {
+	Backend string            `mapstructure:"backend"` // memory | redis (database.redis)
+	Methods []MethodRateLimit `mapstructure:"methods"`
+
+	// TrustedProxies — сколько доверенных прокси (балансировщиков) стоит перед HTTP gateway.
+	// Каждый дописывает адрес в X-Forwarded-For, клиентом считается запись TrustedProxies от конца.
+	TrustedProxies int `mapstructure:"trusted_proxies"`
+
+	// MaxStreams — сколько стримов один пользователь (или IP без пользователя) держит открытыми одновременно
+	// на одной реплике; 0 — без ограничения.
+	MaxStreams int `mapstructure:"max_streams"`
+
+	// FailOpen пропускает запросы, когда бэкенд лимитов недоступен; по умолчанию они отклоняются с Unavailable.
+	FailOpen bool `mapstructure:"fail_open"`
+}
+
+// MethodRateLimit — token bucket для одного RPC-метода; нулевой Rate отключает соответствующий лимит.
+type MethodRateLimit struct {
+	Method  string    `mapstructure:"method"` // полное имя, например /voting.v1.UserService/VotePoll
+	PerUser RateLimit `mapstructure:"per_user"`
+	PerIP   RateLimit `mapstructure:"per_ip"`
+}
+
+type RateLimit struct {
+	Rate  int           `mapstructure:"rate"` // сколько токенов восполняется за Per
+	Per   time.Duration `mapstructure:"per"`
+	Burst int           `mapstructure:"burst"` // ёмкость корзины, по умолчанию Rate
+}
+
+type WorkersConfig struct {
+	Expiry struct {
+		Interval time.Duration `mapstructure:"interval"`
+	} `mapstructure:"expiry"`
+	Live struct {
+		Interval time.Duration `mapstructure:"interval"`
+	} `mapstructure:"live"`
 }
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	OAuth    OAuthConfig    `mapstructure:"oauth"`
-	Rabbit   RabbitConfig   `mapstructure:"rabbit"`
-	Kafka    KafkaConfig    `mapstructure:"kafka"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Swagger  SwaggerConfig  `mapstructure:"swagger"`
+	Server    ServerConfig    `mapstructure:"server"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	OAuth     OAuthConfig     `mapstructure:"oauth"`
+	Rabbit    RabbitConfig    `mapstructure:"rabbit"`
+	Kafka     KafkaConfig     `mapstructure:"kafka"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Swagger   SwaggerConfig   `mapstructure:"swagger"`
+	Workers   WorkersConfig   `mapstructure:"workers"`
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
 }
 
 func LoadConfig() (Config, error) {
@@ -0,0 +1,109 @@
+package dbx
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCursor is returned for page tokens that are malformed or were issued for another sort order.
+var ErrInvalidCursor = errors.New("invalid page token")
+
+// sortKey — активная сортировка запроса; id всегда добавляется вторым ключом, чтобы порядок был строгим.
+type sortKey struct {
+	Column string
+	Desc   bool
+	Field  string // колонка строки со значением для курсора, если Column — выражение
+}
+
+func (s sortKey) field() string {
+	if s.Field != "" {
+		return s.Field
+	}
+	return s.Column
+}
+
+func (s sortKey) String() string {
+	if s.Desc {
+		return s.Column + " desc"
+	}
+	return s.Column + " asc"
+}
+
+func (s sortKey) orderBy() []string {
+	dir := "ASC"
+	if s.Desc {
+		dir = "DESC"
+	}
+	return []string{s.Column + " " + dir, "id " + dir}
+}
+
+// after — условие keyset-пагинации: строки строго после курсора в порядке сортировки.
+func (s sortKey) after(c Cursor) sq.Sqlizer {
+	op := ">"
+	if s.Desc {
+		op = "<"
+	}
+	return sq.Expr(fmt.Sprintf("(%s, id) %s (?, ?)", s.Column, op), c.Value, c.ID)
+}
+
+// Cursor is the position of the last row of a page: its sort key value and id.
+type Cursor struct {
+	Sort  string    `json:"s"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+func newCursor(s sortKey, value any, id uuid.UUID) Cursor {
+	var v string
+	switch val := value.(type) {
+	case time.Time:
+		v = val.UTC().Format(time.RFC3339Nano)
+	case int:
+		v = strconv.Itoa(val)
+	default:
+		v = fmt.Sprint(val)
+	}
+	return Cursor{Sort: s.String(), Value: v, ID: id}
+}
+
+// Encode returns the opaque page token of the cursor.
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a page token; an empty token means the first page.
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.Sort == "" || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// keysetPage применяет курсор и лимит к селектору; counter не трогается, так что Count
+// всегда считает все строки под фильтрами. Курсор от другой сортировки отклоняется.
+func keysetPage(selector sq.SelectBuilder, s sortKey, limit uint64, after *Cursor) (sq.SelectBuilder, error) {
+	if after != nil {
+		if after.Sort != s.String() {
+			return selector, ErrInvalidCursor
+		}
+		selector = selector.Where(s.after(*after))
+	}
+	return selector.Limit(limit), nil
+}
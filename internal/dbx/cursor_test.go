@@ -0,0 +1,138 @@
+package dbx
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	row := Poll{
+		ID:        uuid.New(),
+		CreatedAt: time.Date(2026, 10, 17, 12, 30, 0, 123456789, time.FixedZone("EEST", 3*60*60)),
+	}
+	q := NewPollsQ(nil).OrderByCreatedDesc()
+
+	c := q.Cursor(row)
+	if c.Sort != "created_at desc" || c.ID != row.ID {
+		t.Fatalf("cursor = %+v", c)
+	}
+	// время хранится в UTC с наносекундами, чтобы сравнение в SQL не теряло точность
+	if c.Value != "2026-10-17T09:30:00.123456789Z" {
+		t.Fatalf("value = %q", c.Value)
+	}
+
+	got, err := DecodeCursor(c.Encode())
+	if err != nil {
+		t.Fatalf("DecodeCursor: %v", err)
+	}
+	if !reflect.DeepEqual(*got, c) {
+		t.Fatalf("decoded %+v, want %+v", *got, c)
+	}
+}
+
+func TestDecodeCursorEmptyToken(t *testing.T) {
+	c, err := DecodeCursor("")
+	if c != nil || err != nil {
+		t.Fatalf("got %v, %v; want the first page", c, err)
+	}
+}
+
+func TestDecodeCursorRejectsBadTokens(t *testing.T) {
+	valid := Cursor{Sort: "created_at asc", Value: "2026-10-17T09:30:00Z", ID: uuid.New()}.Encode()
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := map[string]string{
+		"not base64":     "%%%",
+		"padded base64":  base64.URLEncoding.EncodeToString([]byte(`{"s":"created_at asc"}`)),
+		"not json":       encode("created_at asc"),
+		"truncated":      valid[:len(valid)/2],
+		"no sort":        encode(`{"v":"2026-10-17T09:30:00Z","id":"` + uuid.NewString() + `"}`),
+		"no id":          encode(`{"s":"created_at asc","v":"2026-10-17T09:30:00Z"}`),
+		"malformed id":   encode(`{"s":"created_at asc","v":"x","id":"42"}`),
+		"wrong id type":  encode(`{"s":"created_at asc","v":"x","id":42}`),
+		"trailing bytes": valid + "AA",
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := DecodeCursor(token); !errors.Is(err, ErrInvalidCursor) {
+				t.Fatalf("err = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
+
+// Курсор помнит сортировку, под которую выдан: с другой сортировкой он указывал бы не на ту позицию.
+func TestPageRejectsCursorOfAnotherSort(t *testing.T) {
+	row := Poll{ID: uuid.New(), CreatedAt: time.Now()}
+	asc := NewPollsQ(nil).OrderByCreatedAsc().Cursor(row)
+
+	if _, err := NewPollsQ(nil).OrderByCreatedDesc().Page(10, &asc); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("desc page with asc cursor: err = %v, want ErrInvalidCursor", err)
+	}
+	if _, err := NewPollsQ(nil).OrderByRank().Page(10, &asc); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("rank page with asc cursor: err = %v, want ErrInvalidCursor", err)
+	}
+	// без явной сортировки страницы идут по created_at asc
+	if _, err := NewPollsQ(nil).Page(10, &asc); err != nil {
+		t.Fatalf("default page with asc cursor: %v", err)
+	}
+}
+
+// Строки с одинаковым ключом сортировки различает id: курсор стоит на (значение, id),
+// и следующая страница начинается с той из них, что идёт после него, а не со следующего значения.
+func TestPageBreaksTiesByID(t *testing.T) {
+	created := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+	last := Poll{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), CreatedAt: created}
+
+	tests := []struct {
+		name  string
+		q     PollsQ
+		where string
+		order string
+	}{
+		{
+			name:  "ascending",
+			q:     NewPollsQ(nil).OrderByCreatedAsc(),
+			where: "WHERE (created_at, id) > ($1, $2)",
+			order: "ORDER BY created_at ASC, id ASC LIMIT 11",
+		},
+		{
+			name:  "descending",
+			q:     NewPollsQ(nil).OrderByCreatedDesc(),
+			where: "WHERE (created_at, id) < ($1, $2)",
+			order: "ORDER BY created_at DESC, id DESC LIMIT 11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := DecodeCursor(tt.q.Cursor(last).Encode())
+			if err != nil {
+				t.Fatalf("DecodeCursor: %v", err)
+			}
+			q, err := tt.q.Page(11, c)
+			if err != nil {
+				t.Fatalf("Page: %v", err)
+			}
+
+			query, args, err := q.selector.ToSql()
+			if err != nil {
+				t.Fatalf("ToSql: %v", err)
+			}
+			if !strings.Contains(query, tt.where) || !strings.HasSuffix(query, tt.order) {
+				t.Fatalf("unexpected statement: %s", query)
+			}
+			want := []interface{}{"2026-10-17T09:30:00Z", last.ID}
+			if !reflect.DeepEqual(args, want) {
+				t.Fatalf("args = %v, want %v", args, want)
+			}
+		})
+	}
+}
@@ -49,6 +49,8 @@ BEGIN
             SET signatures = GREATEST(signatures - 1, 0)
             WHERE id = OLD.petition_id;
         RETURN OLD;
+    END IF;
+
     RETURN NULL;
 END;
 $$ LANGUAGE plpgsql;
@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE "proposals"
+    ADD COLUMN "decided_by"      UUID,      -- addressee (or city government official) who issued the verdict
+    ADD COLUMN "decided_at"      TIMESTAMP,
+    ADD COLUMN "final_agreed"    INT CHECK (final_agreed >= 0),   -- agreed_num at decision time
+    ADD COLUMN "final_disagreed" INT CHECK (final_disagreed >= 0); -- disagreed_num at decision time
+
+-- +migrate Down
+ALTER TABLE "proposals"
+    DROP COLUMN IF EXISTS "final_disagreed",
+    DROP COLUMN IF EXISTS "final_agreed",
+    DROP COLUMN IF EXISTS "decided_at",
+    DROP COLUMN IF EXISTS "decided_by";
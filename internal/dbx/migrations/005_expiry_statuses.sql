@@ -0,0 +1,11 @@
+-- +migrate Up notransaction
+-- ALTER TYPE ... ADD VALUE нельзя выполнять внутри транзакции (PostgreSQL < 12)
+ALTER TYPE petition_status ADD VALUE IF NOT EXISTS 'expired';  -- end_date passed, petition closed for signatures
+ALTER TYPE poll_status ADD VALUE IF NOT EXISTS 'closed';       -- end_date passed, results are final
+ALTER TYPE proposal_status ADD VALUE IF NOT EXISTS 'closed';   -- end_date passed, awaiting the addressee's verdict
+
+-- +migrate Down
+-- PostgreSQL не умеет удалять значения из enum; возвращаем строки в ближайший прежний статус
+UPDATE "petitions" SET "status" = 'withdrawn' WHERE "status" = 'expired';
+UPDATE "polls" SET "status" = 'withdrawn' WHERE "status" = 'closed';
+UPDATE "proposals" SET "status" = 'published' WHERE "status" = 'closed';
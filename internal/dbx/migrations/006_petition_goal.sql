@@ -0,0 +1,66 @@
+-- +migrate Up notransaction
+ALTER TYPE petition_status ADD VALUE IF NOT EXISTS 'awaiting_response'; -- goal reached, waiting for the addressee's answer
+
+ALTER TABLE "petitions"
+    ADD COLUMN "goal_reached_at" TIMESTAMP,                       -- moment signatures first reached goal
+    ADD COLUMN "stop_at_goal"    BOOLEAN NOT NULL DEFAULT FALSE;  -- stop collecting as soon as the goal is reached
+
+CREATE OR REPLACE FUNCTION sync_petition_signatures_counter()
+RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        -- правые части SET видят строку до обновления, поэтому signatures + 1 — новое значение
+        UPDATE petitions
+            SET signatures      = signatures + 1,
+                goal_reached_at = CASE
+                    WHEN goal_reached_at IS NULL AND goal > 0 AND signatures + 1 >= goal
+                        THEN timezone('utc', now())
+                    ELSE goal_reached_at
+                END,
+                status          = CASE
+                    WHEN goal_reached_at IS NULL AND goal > 0 AND signatures + 1 >= goal
+                         AND stop_at_goal AND status = 'published'
+                        THEN 'awaiting_response'::petition_status
+                    ELSE status
+                END
+            WHERE id = NEW.petition_id;
+        RETURN NEW;
+
+    ELSIF TG_OP = 'DELETE' THEN
+        -- goal_reached_at не сбрасываем: момент достижения цели уже зафиксирован
+        UPDATE petitions
+            SET signatures = GREATEST(signatures - 1, 0)
+            WHERE id = OLD.petition_id;
+        RETURN OLD;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- +migrate Down
+CREATE OR REPLACE FUNCTION sync_petition_signatures_counter()
+RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE petitions
+            SET signatures = signatures + 1
+            WHERE id = NEW.petition_id;
+        RETURN NEW;
+
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE petitions
+            SET signatures = GREATEST(signatures - 1, 0)
+            WHERE id = OLD.petition_id;
+        RETURN OLD;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE "petitions" SET "status" = 'published' WHERE "status" = 'awaiting_response';
+
+ALTER TABLE "petitions"
+    DROP COLUMN IF EXISTS "stop_at_goal",
+    DROP COLUMN IF EXISTS "goal_reached_at";
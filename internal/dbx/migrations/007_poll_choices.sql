@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE "polls"
+    ADD COLUMN "min_choices" INT NOT NULL DEFAULT 1 CHECK (min_choices >= 1),
+    ADD COLUMN "max_choices" INT NOT NULL DEFAULT 1,
+    ADD CONSTRAINT "polls_choices_range" CHECK (max_choices >= min_choices);
+
+-- пользователь может выбрать несколько вариантов, но каждый — не более одного раза
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_poll_id_user_id_key";
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_poll_id_user_id_option_id_key" UNIQUE ("poll_id", "user_id", "option_id");
+
+-- +migrate Down
+-- оставляем только самый ранний голос пользователя; триггер поправит votes_count
+DELETE FROM "poll_votes" v
+    USING "poll_votes" e
+    WHERE v.poll_id = e.poll_id
+      AND v.user_id = e.user_id
+      AND (v.created_at, v.id) > (e.created_at, e.id);
+
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_poll_id_user_id_option_id_key";
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_poll_id_user_id_key" UNIQUE ("poll_id", "user_id");
+
+ALTER TABLE "polls"
+    DROP CONSTRAINT IF EXISTS "polls_choices_range",
+    DROP COLUMN IF EXISTS "max_choices",
+    DROP COLUMN IF EXISTS "min_choices";
@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TYPE poll_type AS ENUM (
+    'choice', -- pick between min_choices and max_choices options
+    'ranked'  -- rank options in order of preference, tallied by instant-runoff
+);
+
+ALTER TABLE "polls" ADD COLUMN "type" poll_type NOT NULL DEFAULT 'choice';
+
+-- для ranked-опросов каждая строка — вариант бюллетеня с его местом (1 — самый предпочтительный);
+-- votes_count в poll_options тогда означает число бюллетеней, где вариант вообще упомянут
+ALTER TABLE "poll_votes" ADD COLUMN "rank" SMALLINT CHECK (rank >= 1);
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_poll_id_user_id_rank_key" UNIQUE ("poll_id", "user_id", "rank");
+
+-- +migrate Down
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_poll_id_user_id_rank_key";
+ALTER TABLE "poll_votes" DROP COLUMN IF EXISTS "rank";
+
+ALTER TABLE "polls" DROP COLUMN IF EXISTS "type";
+
+DROP TYPE IF EXISTS poll_type;
@@ -0,0 +1,44 @@
+-- +migrate Up
+ALTER TABLE "polls" ADD COLUMN "secret" BOOLEAN NOT NULL DEFAULT FALSE; -- votes are stored without user linkage
+
+-- факт участия в тайном голосовании; защищает от повторного голоса, не раскрывая выбор
+CREATE TABLE "poll_participants" (
+    "id"         UUID      PRIMARY KEY NOT NULL,
+    "poll_id"    UUID      NOT NULL REFERENCES "polls" ("id") ON DELETE CASCADE,
+    "user_id"    UUID      NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    UNIQUE ("poll_id", "user_id")
+);
+
+-- ballot_id объединяет строки одного бюллетеня; для тайных опросов user_id = NULL
+ALTER TABLE "poll_votes" ADD COLUMN "ballot_id" UUID;
+
+UPDATE "poll_votes" v
+    SET ballot_id = b.ballot_id
+    FROM (
+        SELECT poll_id, user_id, uuid_generate_v4() AS ballot_id
+        FROM poll_votes
+        GROUP BY poll_id, user_id
+    ) b
+    WHERE v.poll_id = b.poll_id AND v.user_id = b.user_id;
+
+ALTER TABLE "poll_votes" ALTER COLUMN "ballot_id" SET NOT NULL;
+ALTER TABLE "poll_votes" ALTER COLUMN "user_id" DROP NOT NULL;
+
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_poll_id_user_id_rank_key";
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_ballot_id_option_id_key" UNIQUE ("ballot_id", "option_id");
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_ballot_id_rank_key" UNIQUE ("ballot_id", "rank");
+
+-- +migrate Down
+DELETE FROM "poll_votes" WHERE "user_id" IS NULL;
+
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_ballot_id_rank_key";
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_ballot_id_option_id_key";
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_poll_id_user_id_rank_key" UNIQUE ("poll_id", "user_id", "rank");
+
+ALTER TABLE "poll_votes" ALTER COLUMN "user_id" SET NOT NULL;
+ALTER TABLE "poll_votes" DROP COLUMN IF EXISTS "ballot_id";
+
+DROP TABLE IF EXISTS "poll_participants" CASCADE;
+
+ALTER TABLE "polls" DROP COLUMN IF EXISTS "secret";
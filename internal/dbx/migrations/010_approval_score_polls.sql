@@ -0,0 +1,15 @@
+-- +migrate Up notransaction
+ALTER TYPE poll_type ADD VALUE IF NOT EXISTS 'approval'; -- mark any number of options
+ALTER TYPE poll_type ADD VALUE IF NOT EXISTS 'score';    -- rate every option from 0 to max_score
+
+ALTER TABLE "polls" ADD COLUMN "max_score" SMALLINT NOT NULL DEFAULT 0 CHECK (max_score >= 0);
+
+-- оценка варианта в score-опросах; NULL для остальных типов
+ALTER TABLE "poll_votes" ADD COLUMN "score" SMALLINT CHECK (score >= 0);
+
+-- +migrate Down
+ALTER TABLE "poll_votes" DROP COLUMN IF EXISTS "score";
+ALTER TABLE "polls" DROP COLUMN IF EXISTS "max_score";
+
+-- значения enum удалить нельзя; переводим такие опросы в обычный выбор
+UPDATE "polls" SET "type" = 'choice' WHERE "type" IN ('approval', 'score');
@@ -0,0 +1,29 @@
+-- +migrate Up
+CREATE TYPE moderation_action AS ENUM (
+    'publish',          -- approved by a moderator and published
+    'decline',          -- declined by a moderator, reason is required
+    'withdraw',         -- force-withdrawn by a moderator
+    'edit',             -- title/description edited by a moderator
+    'delete_signature', -- abusive petition signature removed
+    'delete_vote'       -- abusive poll or proposal vote removed
+);
+
+-- журнал действий модераторов; строки только добавляются
+CREATE TABLE "moderation_log" (
+    "id"             UUID              PRIMARY KEY NOT NULL,
+    "moderator_id"   UUID              NOT NULL,
+    "entity_type"    VARCHAR(16)       NOT NULL CHECK (entity_type IN ('petition', 'poll', 'proposal')),
+    "entity_id"      UUID              NOT NULL,
+    "action"         moderation_action NOT NULL,
+    "reason"         VARCHAR(1024),
+    "target_user_id" UUID, -- чья подпись или голос удалены
+    "created_at"     TIMESTAMP         NOT NULL,
+    CHECK (action <> 'decline' OR reason IS NOT NULL)
+);
+
+CREATE INDEX "moderation_log_entity_idx" ON "moderation_log" ("entity_id", "created_at");
+CREATE INDEX "moderation_log_moderator_idx" ON "moderation_log" ("moderator_id", "created_at");
+
+-- +migrate Down
+DROP TABLE IF EXISTS "moderation_log";
+DROP TYPE IF EXISTS moderation_action;
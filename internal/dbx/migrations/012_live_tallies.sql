@@ -0,0 +1,36 @@
+-- +migrate Up
+-- уведомления о смене счётчиков для live-трансляций; одинаковые NOTIFY в одной транзакции Postgres схлопывает
+CREATE OR REPLACE FUNCTION notify_poll_tally()
+RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('poll_tally', NEW.poll_id::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER poll_options_after_upd_votes
+    AFTER UPDATE OF votes_count ON poll_options
+    FOR EACH ROW
+    WHEN (OLD.votes_count IS DISTINCT FROM NEW.votes_count)
+    EXECUTE FUNCTION notify_poll_tally();
+
+CREATE OR REPLACE FUNCTION notify_proposal_tally()
+RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('proposal_tally', NEW.id::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER proposals_after_upd_tally
+    AFTER UPDATE OF agreed_num, disagreed_num ON proposals
+    FOR EACH ROW
+    WHEN (OLD.agreed_num IS DISTINCT FROM NEW.agreed_num OR OLD.disagreed_num IS DISTINCT FROM NEW.disagreed_num)
+    EXECUTE FUNCTION notify_proposal_tally();
+
+-- +migrate Down
+DROP TRIGGER IF EXISTS proposals_after_upd_tally ON proposals;
+DROP FUNCTION IF EXISTS notify_proposal_tally();
+
+DROP TRIGGER IF EXISTS poll_options_after_upd_votes ON poll_options;
+DROP FUNCTION IF EXISTS notify_poll_tally();
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- страницы выбираются по (created_at, id), без OFFSET
+CREATE INDEX "petitions_created_id_idx" ON "petitions" ("created_at", "id");
+CREATE INDEX "polls_created_id_idx" ON "polls" ("created_at", "id");
+CREATE INDEX "proposals_created_id_idx" ON "proposals" ("created_at", "id");
+
+DROP INDEX IF EXISTS "moderation_log_entity_idx";
+CREATE INDEX "moderation_log_entity_idx" ON "moderation_log" ("entity_id", "created_at", "id");
+
+-- +migrate Down
+DROP INDEX IF EXISTS "moderation_log_entity_idx";
+CREATE INDEX "moderation_log_entity_idx" ON "moderation_log" ("entity_id", "created_at");
+
+DROP INDEX IF EXISTS "proposals_created_id_idx";
+DROP INDEX IF EXISTS "polls_created_id_idx";
+DROP INDEX IF EXISTS "petitions_created_id_idx";
@@ -0,0 +1,47 @@
+-- +migrate Up
+-- в стандартной поставке Postgres нет украинского словаря: конфигурация без стемминга, только нормализация регистра
+CREATE TEXT SEARCH CONFIGURATION ukrainian (COPY = pg_catalog.simple);
+
+-- заголовок весит больше описания (A > B); каждый текст разбирается всеми тремя конфигурациями,
+-- чтобы запрос на любом из языков находил свои лексемы
+ALTER TABLE "petitions" ADD COLUMN "search_vector" TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', title), 'A') ||
+    setweight(to_tsvector('ukrainian', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('russian', description), 'B') ||
+    setweight(to_tsvector('ukrainian', description), 'B') ||
+    setweight(to_tsvector('english', description), 'B')
+) STORED;
+
+ALTER TABLE "polls" ADD COLUMN "search_vector" TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', title), 'A') ||
+    setweight(to_tsvector('ukrainian', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('russian', description), 'B') ||
+    setweight(to_tsvector('ukrainian', description), 'B') ||
+    setweight(to_tsvector('english', description), 'B')
+) STORED;
+
+ALTER TABLE "proposals" ADD COLUMN "search_vector" TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', title), 'A') ||
+    setweight(to_tsvector('ukrainian', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('russian', description), 'B') ||
+    setweight(to_tsvector('ukrainian', description), 'B') ||
+    setweight(to_tsvector('english', description), 'B')
+) STORED;
+
+CREATE INDEX "petitions_search_idx" ON "petitions" USING GIN ("search_vector");
+CREATE INDEX "polls_search_idx" ON "polls" USING GIN ("search_vector");
+CREATE INDEX "proposals_search_idx" ON "proposals" USING GIN ("search_vector");
+
+-- +migrate Down
+DROP INDEX IF EXISTS "proposals_search_idx";
+DROP INDEX IF EXISTS "polls_search_idx";
+DROP INDEX IF EXISTS "petitions_search_idx";
+
+ALTER TABLE "proposals" DROP COLUMN IF EXISTS "search_vector";
+ALTER TABLE "polls" DROP COLUMN IF EXISTS "search_vector";
+ALTER TABLE "petitions" DROP COLUMN IF EXISTS "search_vector";
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS ukrainian;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- у одного опроса не бывает двух вариантов с одинаковым текстом (без учёта регистра)
+CREATE UNIQUE INDEX "poll_options_text_uniq" ON "poll_options" ("poll_id", lower("option_text"));
+
+-- +migrate Down
+DROP INDEX IF EXISTS "poll_options_text_uniq";
@@ -0,0 +1,27 @@
+-- +migrate Up
+-- порядок вариантов задаётся явно, а не временем создания
+ALTER TABLE "poll_options" ADD COLUMN "position" INT NOT NULL DEFAULT 0 CHECK (position >= 0);
+
+UPDATE "poll_options" o
+    SET "position" = n.position
+    FROM (
+        SELECT "id", ROW_NUMBER() OVER (PARTITION BY "poll_id" ORDER BY "created_at", "id") - 1 AS position
+        FROM "poll_options"
+    ) n
+    WHERE o."id" = n."id";
+
+CREATE INDEX "poll_options_poll_position_idx" ON "poll_options" ("poll_id", "position");
+
+-- удалить вариант с голосами нельзя: каскад молча стёр бы эти голоса.
+-- NO ACTION проверяется в конце оператора, поэтому удаление опроса целиком по-прежнему проходит
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_option_id_fkey";
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_option_id_fkey"
+    FOREIGN KEY ("option_id") REFERENCES "poll_options" ("id");
+
+-- +migrate Down
+ALTER TABLE "poll_votes" DROP CONSTRAINT IF EXISTS "poll_votes_option_id_fkey";
+ALTER TABLE "poll_votes" ADD CONSTRAINT "poll_votes_option_id_fkey"
+    FOREIGN KEY ("option_id") REFERENCES "poll_options" ("id") ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS "poll_options_poll_position_idx";
+ALTER TABLE "poll_options" DROP COLUMN IF EXISTS "position";
@@ -0,0 +1,20 @@
+-- +migrate Up
+-- город сущности пишется вместе с записью: доступ к истории проверяется по журналу,
+-- а не по строке сущности, которой уже может не быть
+ALTER TABLE "moderation_log" ADD COLUMN "city_id" UUID;
+
+UPDATE "moderation_log" l SET "city_id" = p."city_id"
+    FROM "petitions" p WHERE l."entity_type" = 'petition' AND l."entity_id" = p."id";
+UPDATE "moderation_log" l SET "city_id" = p."city_id"
+    FROM "polls" p WHERE l."entity_type" = 'poll' AND l."entity_id" = p."id";
+UPDATE "moderation_log" l SET "city_id" = p."city_id"
+    FROM "proposals" p WHERE l."entity_type" = 'proposal' AND l."entity_id" = p."id";
+
+-- NULL остаётся только у записей о сущностях, удалённых до этой миграции: их город уже не восстановить.
+-- Новые записи обязаны его указать
+ALTER TABLE "moderation_log" ADD CONSTRAINT "moderation_log_city_id_check"
+    CHECK ("city_id" IS NOT NULL) NOT VALID;
+
+-- +migrate Down
+ALTER TABLE "moderation_log" DROP CONSTRAINT IF EXISTS "moderation_log_city_id_check";
+ALTER TABLE "moderation_log" DROP COLUMN IF EXISTS "city_id";
@@ -0,0 +1,96 @@
+package dbx
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const moderationLogTable = "moderation_log"
+
+// ModerationEntry — одно действие модератора. Журнал только пополняется, поэтому Update/Delete нет.
+type ModerationEntry struct {
+	ID           uuid.UUID  `db:"id"`
+	ModeratorID  uuid.UUID  `db:"moderator_id"`
+	EntityType   string     `db:"entity_type"` // petition | poll | proposal
+	EntityID     uuid.UUID  `db:"entity_id"`
+	CityID       *uuid.UUID `db:"city_id"` // NULL только у записей, сделанных до появления колонки
+	Action       string     `db:"action"`  // moderation_action
+	Reason       *string    `db:"reason"`
+	TargetUserID *uuid.UUID `db:"target_user_id"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+// ModerationLogQ держит table не встроенной: наружу выходят только чтение и вставка.
+type ModerationLogQ struct {
+	t table[ModerationEntry]
+}
+
+func NewModerationLogQ(db *sql.DB) ModerationLogQ {
+	return ModerationLogQ{t: newTable[ModerationEntry](db, moderationLogTable)}
+}
+
+func (q ModerationLogQ) New() ModerationLogQ {
+	return NewModerationLogQ(q.t.db)
+}
+
+func (q ModerationLogQ) Insert(ctx context.Context, in ModerationEntry) error {
+	return q.t.insert(ctx, map[string]interface{}{
+		"id":             in.ID,
+		"moderator_id":   in.ModeratorID,
+		"entity_type":    in.EntityType,
+		"entity_id":      in.EntityID,
+		"city_id":        in.CityID,
+		"action":         in.Action,
+		"reason":         in.Reason,
+		"target_user_id": in.TargetUserID,
+		"created_at":     in.CreatedAt,
+	})
+}
+
+func (q ModerationLogQ) Get(ctx context.Context) (ModerationEntry, error) {
+	return q.t.Get(ctx)
+}
+
+func (q ModerationLogQ) Select(ctx context.Context) ([]ModerationEntry, error) {
+	return q.t.Select(ctx)
+}
+
+// ---- Filters
+
+func (q ModerationLogQ) FilterEntityID(entityID uuid.UUID) ModerationLogQ {
+	q.t = q.t.whereRead(sq.Eq{"entity_id": entityID})
+	return q
+}
+
+func (q ModerationLogQ) FilterModeratorID(moderatorID uuid.UUID) ModerationLogQ {
+	q.t = q.t.whereRead(sq.Eq{"moderator_id": moderatorID})
+	return q
+}
+
+func (q ModerationLogQ) OrderByCreatedDesc() ModerationLogQ {
+	q.t = q.t.orderBy(sortKey{Column: "created_at", Desc: true})
+	return q
+}
+
+// ---- Пагинация и count
+
+func (q ModerationLogQ) Count(ctx context.Context) (uint64, error) {
+	return q.t.Count(ctx)
+}
+
+// Page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (q ModerationLogQ) Page(limit uint64, after *Cursor) (ModerationLogQ, error) {
+	var err error
+	q.t, err = q.t.page(limit, after)
+	return q, err
+}
+
+// Cursor returns the position of the row in the active sort order, to be passed to Page for the next page.
+func (q ModerationLogQ) Cursor(r ModerationEntry) Cursor {
+	return q.t.Cursor(r)
+}
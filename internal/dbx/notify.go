@@ -0,0 +1,9 @@
+package dbx
+
+// Каналы LISTEN/NOTIFY, в которые триггеры публикуют ID изменившейся строки.
+const (
+	// PollTallyChannel — изменился votes_count у варианта; payload — poll_id.
+	PollTallyChannel = "poll_tally"
+	// ProposalTallyChannel — изменились agreed_num/disagreed_num; payload — id предложения.
+	ProposalTallyChannel = "proposal_tally"
+)
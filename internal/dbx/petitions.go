@@ -3,7 +3,6 @@ package dbx
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -18,63 +17,37 @@ type GeoPoint struct {
 }
 
 type Petition struct {
-	ID          uuid.UUID  `db:"id"`
-	CityID      uuid.UUID  `db:"city_id"`
-	Title       string     `db:"title"`
-	Description string     `db:"description"`
-	InitiatorID uuid.UUID  `db:"initiator_id"`
-	AddressToID *uuid.UUID `db:"address_to_id"` // NULLable
-	Status      string     `db:"status"`        // petition_status
-	Signatures  int        `db:"signatures"`
-	Goal        int        `db:"goal"`
-	EndDate     time.Time  `db:"end_date"`
-	CreatedAt   time.Time  `db:"created_at"`
-	UpdatedAt   time.Time  `db:"updated_at"`
-
-	// Прочитанные из ST_Y/ST_X координаты; если location NULL — поля будут nil
-	Lat *float64 `db:"lat"`
-	Lng *float64 `db:"lng"`
+	ID            uuid.UUID  `db:"id"`
+	CityID        uuid.UUID  `db:"city_id"`
+	Title         string     `db:"title"`
+	Description   string     `db:"description"`
+	InitiatorID   uuid.UUID  `db:"initiator_id"`
+	AddressToID   *uuid.UUID `db:"address_to_id"` // NULLable
+	Status        string     `db:"status"`        // petition_status
+	Signatures    int        `db:"signatures"`
+	Goal          int        `db:"goal"`
+	StopAtGoal    bool       `db:"stop_at_goal"`
+	GoalReachedAt *time.Time `db:"goal_reached_at"` // ставит триггер; NULL — цель не достигнута
+	EndDate       time.Time  `db:"end_date"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+
+	// PostGIS: сначала долгота (X), потом широта (Y), но для читателя удобнее lat/lng;
+	// если location NULL — поля будут nil
+	Lat *float64 `db:"lat" expr:"ST_Y(location)"`
+	Lng *float64 `db:"lng" expr:"ST_X(location)"`
+
+	// Заполняются только в выборке с Search
+	Rank    float32 `db:"rank,optional"`
+	Snippet string  `db:"snippet,optional"`
 }
 
 type PetitionsQ struct {
-	db       *sql.DB
-	selector sq.SelectBuilder
-	inserter sq.InsertBuilder
-	updater  sq.UpdateBuilder
-	deleter  sq.DeleteBuilder
-	counter  sq.SelectBuilder
+	table[Petition]
 }
 
 func NewPetitionsQ(db *sql.DB) PetitionsQ {
-	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-
-	// Явно выбираем колонки + вычисляем lat/lng из geometry
-	selectCols := []string{
-		"id",
-		"city_id",
-		"title",
-		"description",
-		"initiator_id",
-		"address_to_id",
-		"status",
-		"signatures",
-		"goal",
-		"end_date",
-		"created_at",
-		"updated_at",
-		// PostGIS: сначала долгота (X), потом широта (Y), но для читателя удобнее lat/lng
-		"ST_Y(location) AS lat",
-		"ST_X(location) AS lng",
-	}
-
-	return PetitionsQ{
-		db:       db,
-		selector: builder.Select(selectCols...).From(petitionsTable),
-		inserter: builder.Insert(petitionsTable),
-		updater:  builder.Update(petitionsTable),
-		deleter:  builder.Delete(petitionsTable),
-		counter:  builder.Select("COUNT(*) AS count").From(petitionsTable),
-	}
+	return PetitionsQ{newTable[Petition](db, petitionsTable)}
 }
 
 func (q PetitionsQ) New() PetitionsQ {
@@ -91,6 +64,7 @@ type InsertPetitionInput struct {
 	Status      string
 	Signatures  int
 	Goal        int
+	StopAtGoal  bool
 	EndDate     time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -98,7 +72,7 @@ type InsertPetitionInput struct {
 }
 
 func (q PetitionsQ) Insert(ctx context.Context, in InsertPetitionInput) error {
-	values := map[string]interface{}{
+	return q.insert(ctx, map[string]interface{}{
 		"id":            in.ID,
 		"city_id":       in.CityID,
 		"title":         in.Title,
@@ -108,106 +82,12 @@ func (q PetitionsQ) Insert(ctx context.Context, in InsertPetitionInput) error {
 		"status":        in.Status,
 		"signatures":    in.Signatures,
 		"goal":          in.Goal,
+		"stop_at_goal":  in.StopAtGoal,
 		"end_date":      in.EndDate,
 		"created_at":    in.CreatedAt,
 		"updated_at":    in.UpdatedAt,
-	}
-
-	if in.Location != nil {
-		// location = ST_SetSRID(ST_MakePoint(lng, lat), 4326)
-		values["location"] = sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", in.Location.Lng, in.Location.Lat)
-	} else {
-		values["location"] = nil
-	}
-
-	query, args, err := q.inserter.SetMap(values).ToSql()
-	if err != nil {
-		return fmt.Errorf("building inserter query for table %s: %w", petitionsTable, err)
-	}
-
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
-}
-
-func (q PetitionsQ) Get(ctx context.Context) (Petition, error) {
-	query, args, err := q.selector.Limit(1).ToSql()
-	if err != nil {
-		return Petition{}, fmt.Errorf("building selector query for table %s: %w", petitionsTable, err)
-	}
-
-	var p Petition
-	var row *sql.Row
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		row = tx.QueryRowContext(ctx, query, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, query, args...)
-	}
-
-	err = row.Scan(
-		&p.ID,
-		&p.CityID,
-		&p.Title,
-		&p.Description,
-		&p.InitiatorID,
-		&p.AddressToID,
-		&p.Status,
-		&p.Signatures,
-		&p.Goal,
-		&p.EndDate,
-		&p.CreatedAt,
-		&p.UpdatedAt,
-		&p.Lat,
-		&p.Lng,
-	)
-
-	return p, err
-}
-
-func (q PetitionsQ) Select(ctx context.Context) ([]Petition, error) {
-	query, args, err := q.selector.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("building selector query for table %s: %w", petitionsTable, err)
-	}
-
-	var rows *sql.Rows
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var out []Petition
-	for rows.Next() {
-		var p Petition
-		if err := rows.Scan(
-			&p.ID,
-			&p.CityID,
-			&p.Title,
-			&p.Description,
-			&p.InitiatorID,
-			&p.AddressToID,
-			&p.Status,
-			&p.Signatures,
-			&p.Goal,
-			&p.EndDate,
-			&p.CreatedAt,
-			&p.UpdatedAt,
-			&p.Lat,
-			&p.Lng,
-		); err != nil {
-			return nil, err
-		}
-		out = append(out, p)
-	}
-	return out, nil
+		"location":      geoPoint(in.Location),
+	})
 }
 
 type UpdatePetitionInput struct {
@@ -216,10 +96,11 @@ type UpdatePetitionInput struct {
 	AddressToID **uuid.UUID // отличаем "поставить NULL" от "не менять": передайте &ptr, где ptr может быть nil
 	Status      *string
 	Goal        *int
+	StopAtGoal  *bool
 	EndDate     *time.Time
 	UpdatedAt   *time.Time
 	Location    *GeoPoint // nil -> не менять; Location с нулями не трогаем — это на уровне бизнес-логики решайте
-	// Подписи обычно не правят напрямую — инкремент через отдельный метод (см. ниже)
+	// Подписи обычно не правят напрямую — их ведёт триггер на petition_signatures
 }
 
 func (q PetitionsQ) Update(ctx context.Context, in UpdatePetitionInput) error {
@@ -241,6 +122,9 @@ func (q PetitionsQ) Update(ctx context.Context, in UpdatePetitionInput) error {
 	if in.Goal != nil {
 		updates["goal"] = *in.Goal
 	}
+	if in.StopAtGoal != nil {
+		updates["stop_at_goal"] = *in.StopAtGoal
+	}
 	if in.EndDate != nil {
 		updates["end_date"] = *in.EndDate
 	}
@@ -248,132 +132,109 @@ func (q PetitionsQ) Update(ctx context.Context, in UpdatePetitionInput) error {
 		updates["updated_at"] = *in.UpdatedAt
 	}
 	if in.Location != nil {
-		updates["location"] = sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", in.Location.Lng, in.Location.Lat)
-	}
-
-	if len(updates) == 0 {
-		return nil
-	}
-
-	query, args, err := q.updater.SetMap(updates).ToSql()
-	if err != nil {
-		return fmt.Errorf("building updater query for table %s: %w", petitionsTable, err)
+		updates["location"] = geoPoint(in.Location)
 	}
 
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
-}
-
-func (q PetitionsQ) Delete(ctx context.Context) error {
-	query, args, err := q.deleter.ToSql()
-	if err != nil {
-		return fmt.Errorf("building deleter query for table %s: %w", petitionsTable, err)
-	}
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	return q.update(ctx, updates)
 }
 
 // -------- Фильтры
 
 func (q PetitionsQ) FilterID(id uuid.UUID) PetitionsQ {
-	q.selector = q.selector.Where(sq.Eq{"id": id})
-	q.counter = q.counter.Where(sq.Eq{"id": id})
-	q.updater = q.updater.Where(sq.Eq{"id": id})
-	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.table = q.where(sq.Eq{"id": id})
 	return q
 }
 
 func (q PetitionsQ) FilterCityID(cityID uuid.UUID) PetitionsQ {
-	q.selector = q.selector.Where(sq.Eq{"city_id": cityID})
-	q.counter = q.counter.Where(sq.Eq{"city_id": cityID})
-	q.updater = q.updater.Where(sq.Eq{"city_id": cityID})
-	q.deleter = q.deleter.Where(sq.Eq{"city_id": cityID})
+	q.table = q.where(sq.Eq{"city_id": cityID})
 	return q
 }
 
 func (q PetitionsQ) FilterInitiatorID(initiatorID uuid.UUID) PetitionsQ {
-	q.selector = q.selector.Where(sq.Eq{"initiator_id": initiatorID})
-	q.counter = q.counter.Where(sq.Eq{"initiator_id": initiatorID})
-	q.updater = q.updater.Where(sq.Eq{"initiator_id": initiatorID})
-	q.deleter = q.deleter.Where(sq.Eq{"initiator_id": initiatorID})
+	q.table = q.where(sq.Eq{"initiator_id": initiatorID})
 	return q
 }
 
 func (q PetitionsQ) FilterStatus(status string) PetitionsQ {
-	q.selector = q.selector.Where(sq.Eq{"status": status})
-	q.counter = q.counter.Where(sq.Eq{"status": status})
-	q.updater = q.updater.Where(sq.Eq{"status": status})
-	q.deleter = q.deleter.Where(sq.Eq{"status": status})
+	q.table = q.where(sq.Eq{"status": status})
+	return q
+}
+
+// FilterEndDateBefore — записи, у которых end_date уже наступил к моменту t.
+func (q PetitionsQ) FilterEndDateBefore(t time.Time) PetitionsQ {
+	q.table = q.where(sq.LtOrEq{"end_date": t})
+	return q
+}
+
+// FilterGoalReached — петиции, набравшие (reached=true) или не набравшие цель.
+func (q PetitionsQ) FilterGoalReached(reached bool) PetitionsQ {
+	cond := "goal_reached_at IS NULL"
+	if reached {
+		cond = "goal_reached_at IS NOT NULL"
+	}
+	q.table = q.where(cond)
+	return q
+}
+
+// ForUpdate блокирует выбранные строки до конца транзакции (имеет смысл только внутри Transaction).
+func (q PetitionsQ) ForUpdate() PetitionsQ {
+	q.table = q.forUpdate()
 	return q
 }
 
 func (q PetitionsQ) TitleLike(s string) PetitionsQ {
-	p := fmt.Sprintf("%%%s%%", s)
-	q.selector = q.selector.Where("title ILIKE ?", p)
-	q.counter = q.counter.Where("title ILIKE ?", p)
+	q.table = q.titleLike(s)
+	return q
+}
+
+// Search — полнотекстовый поиск по search_vector; без configs запрос разбирается всеми SearchConfigs.
+// Заполняет Rank и Snippet.
+func (q PetitionsQ) Search(text string, configs ...string) PetitionsQ {
+	q.table = q.search(text, configs)
 	return q
 }
 
 // Геофильтры (PostGIS)
 
 func (q PetitionsQ) BBox(minLng, minLat, maxLng, maxLat float64) PetitionsQ {
-	env := sq.Expr("ST_MakeEnvelope(?, ?, ?, ?, 4326)", minLng, minLat, maxLng, maxLat)
-	q.selector = q.selector.Where(sq.Expr("location IS NOT NULL AND ST_Intersects(location, ?)", env))
-	q.counter = q.counter.Where(sq.Expr("location IS NOT NULL AND ST_Intersects(location, ?)", env))
+	q.table = q.bbox(minLng, minLat, maxLng, maxLat)
 	return q
 }
 
 func (q PetitionsQ) WithinRadius(lng, lat, radiusMeters float64) PetitionsQ {
-	pt := sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", lng, lat)
-	q.selector = q.selector.Where(sq.Expr("location IS NOT NULL AND ST_DWithin(location::geography, (? )::geography, ?)", pt, radiusMeters))
-	q.counter = q.counter.Where(sq.Expr("location IS NOT NULL AND ST_DWithin(location::geography, (? )::geography, ?)", pt, radiusMeters))
+	q.table = q.withinRadius(lng, lat, radiusMeters)
 	return q
 }
 
-// Пагинация и счёт
+// Сортировка
 
-func (q PetitionsQ) Count(ctx context.Context) (uint64, error) {
-	query, args, err := q.counter.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("building count query for table %s: %w", petitionsTable, err)
-	}
+func (q PetitionsQ) OrderByCreatedAsc() PetitionsQ {
+	q.table = q.orderBy(sortKey{Column: "created_at"})
+	return q
+}
 
-	var count uint64
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&count)
-	} else {
-		err = q.db.QueryRowContext(ctx, query, args...).Scan(&count)
-	}
+func (q PetitionsQ) OrderByCreatedDesc() PetitionsQ {
+	q.table = q.orderBy(sortKey{Column: "created_at", Desc: true})
+	return q
+}
 
-	return count, err
+// OrderByRank — сначала самые релевантные; только вместе с Search.
+func (q PetitionsQ) OrderByRank() PetitionsQ {
+	q.table = q.orderBy(rankSort)
+	return q
 }
 
-func (q PetitionsQ) Page(limit, offset uint64) PetitionsQ {
-	q.selector = q.selector.Limit(limit).Offset(offset)
-	q.counter = q.counter.Limit(limit).Offset(offset)
+func (q PetitionsQ) OrderBySignaturesDesc() PetitionsQ {
+	q.table = q.orderBy(sortKey{Column: "signatures", Desc: true})
 	return q
 }
 
-//// Спец-методы для счётчика подписей (если вы храните агрегат)
-//func (q PetitionsQ) IncrementSignatures(ctx context.Context, delta int) error {
-//	query, args, err := q.updater.
-//		Set("signatures", sq.Expr("GREATEST(signatures + ?, 0)", delta)).
-//		ToSql()
-//	if err != nil {
-//		return fmt.Errorf("building increment signatures query: %w", err)
-//	}
-//	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-//		_, err = tx.ExecContext(ctx, query, args...)
-//	} else {
-//		_, err = q.db.ExecContext(ctx, query, args...)
-//	}
-//	return err
-//}
+// Пагинация
+
+// Page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (q PetitionsQ) Page(limit uint64, after *Cursor) (PetitionsQ, error) {
+	var err error
+	q.table, err = q.page(limit, after)
+	return q, err
+}
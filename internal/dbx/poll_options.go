@@ -3,7 +3,6 @@ package dbx
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -17,37 +16,16 @@ type PollOption struct {
 	PollID     uuid.UUID `db:"poll_id"`
 	OptionText string    `db:"option_text"`
 	VotesCount int       `db:"votes_count"`
+	Position   int       `db:"position"` // порядок варианта в опросе, с 0
 	CreatedAt  time.Time `db:"created_at"`
 }
 
 type PollOptionsQ struct {
-	db       *sql.DB
-	selector sq.SelectBuilder
-	inserter sq.InsertBuilder
-	updater  sq.UpdateBuilder
-	deleter  sq.DeleteBuilder
-	counter  sq.SelectBuilder
+	table[PollOption]
 }
 
 func NewPollOptionsQ(db *sql.DB) PollOptionsQ {
-	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-
-	selectCols := []string{
-		"id",
-		"poll_id",
-		"option_text",
-		"votes_count",
-		"created_at",
-	}
-
-	return PollOptionsQ{
-		db:       db,
-		selector: builder.Select(selectCols...).From(pollOptionsTable),
-		inserter: builder.Insert(pollOptionsTable),
-		updater:  builder.Update(pollOptionsTable),
-		deleter:  builder.Delete(pollOptionsTable),
-		counter:  builder.Select("COUNT(*) AS count").From(pollOptionsTable),
-	}
+	return PollOptionsQ{newTable[PollOption](db, pollOptionsTable)}
 }
 
 func (q PollOptionsQ) New() PollOptionsQ {
@@ -60,152 +38,149 @@ type InsertPollOptionInput struct {
 	ID         uuid.UUID
 	PollID     uuid.UUID
 	OptionText string
+	Position   int
 	CreatedAt  time.Time
 }
 
 func (q PollOptionsQ) Insert(ctx context.Context, in InsertPollOptionInput) error {
-	values := map[string]interface{}{
+	return q.insert(ctx, map[string]interface{}{
 		"id":          in.ID,
 		"poll_id":     in.PollID,
 		"option_text": in.OptionText,
+		"position":    in.Position,
 		"created_at":  in.CreatedAt,
-	}
+	})
+}
 
-	query, args, err := q.inserter.SetMap(values).ToSql()
-	if err != nil {
-		return fmt.Errorf("building inserter query for table %s: %w", pollOptionsTable, err)
+// InsertMany вставляет варианты одним многострочным INSERT; пустой список ничего не делает.
+func (q PollOptionsQ) InsertMany(ctx context.Context, in []InsertPollOptionInput) error {
+	if len(in) == 0 {
+		return nil
 	}
 
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
+	inserter := q.New().inserter.Columns("id", "poll_id", "option_text", "position", "created_at")
+	for _, o := range in {
+		inserter = inserter.Values(o.ID, o.PollID, o.OptionText, o.Position, o.CreatedAt)
 	}
-	return err
+	return exec(ctx, q.db, "inserter", pollOptionsTable, inserter)
 }
 
-func (q PollOptionsQ) Get(ctx context.Context) (PollOption, error) {
-	query, args, err := q.selector.Limit(1).ToSql()
-	if err != nil {
-		return PollOption{}, fmt.Errorf("building selector query for table %s: %w", pollOptionsTable, err)
-	}
-
-	var po PollOption
-	var row *sql.Row
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		row = tx.QueryRowContext(ctx, query, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, query, args...)
-	}
+// ---- Update
 
-	err = row.Scan(
-		&po.ID,
-		&po.PollID,
-		&po.OptionText,
-		&po.VotesCount,
-		&po.CreatedAt,
-	)
-	return po, err
+// UpdatePollOptionInput — votes_count здесь нет: его ведёт триггер по poll_votes.
+type UpdatePollOptionInput struct {
+	OptionText *string
+	Position   *int
 }
 
-func (q PollOptionsQ) Select(ctx context.Context) ([]PollOption, error) {
-	query, args, err := q.selector.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("building selector query for table %s: %w", pollOptionsTable, err)
-	}
+func (q PollOptionsQ) Update(ctx context.Context, in UpdatePollOptionInput) error {
+	updates := map[string]interface{}{}
 
-	var rows *sql.Rows
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, query, args...)
-	}
-	if err != nil {
-		return nil, err
+	if in.OptionText != nil {
+		updates["option_text"] = *in.OptionText
 	}
-	defer rows.Close()
-
-	var out []PollOption
-	for rows.Next() {
-		var po PollOption
-		if err := rows.Scan(
-			&po.ID,
-			&po.PollID,
-			&po.OptionText,
-			&po.VotesCount,
-			&po.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		out = append(out, po)
+	if in.Position != nil {
+		updates["position"] = *in.Position
 	}
-	return out, nil
-}
 
-func (q PollOptionsQ) Delete(ctx context.Context) error {
-	query, args, err := q.deleter.ToSql()
-	if err != nil {
-		return fmt.Errorf("building deleter query for table %s: %w", pollOptionsTable, err)
-	}
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	return q.update(ctx, updates)
 }
 
 // ---- Filters
 
 func (q PollOptionsQ) FilterID(id uuid.UUID) PollOptionsQ {
-	q.selector = q.selector.Where(sq.Eq{"id": id})
-	q.counter = q.counter.Where(sq.Eq{"id": id})
-	q.updater = q.updater.Where(sq.Eq{"id": id})
-	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.table = q.where(sq.Eq{"id": id})
 	return q
 }
 
 func (q PollOptionsQ) FilterPollID(pollID uuid.UUID) PollOptionsQ {
-	q.selector = q.selector.Where(sq.Eq{"poll_id": pollID})
-	q.counter = q.counter.Where(sq.Eq{"poll_id": pollID})
-	q.updater = q.updater.Where(sq.Eq{"poll_id": pollID})
-	q.deleter = q.deleter.Where(sq.Eq{"poll_id": pollID})
+	q.table = q.where(sq.Eq{"poll_id": pollID})
+	return q
+}
+
+// FilterPollIDs — варианты сразу нескольких опросов (poll_id IN (...)).
+func (q PollOptionsQ) FilterPollIDs(pollIDs []uuid.UUID) PollOptionsQ {
+	q.table = q.where(sq.Eq{"poll_id": pollIDs})
+	return q
+}
+
+// OrderByPosition — в том порядке, в котором варианты показываются голосующим.
+func (q PollOptionsQ) OrderByPosition() PollOptionsQ {
+	q.table = q.orderBy(sortKey{Column: "position"})
 	return q
 }
 
 func (q PollOptionsQ) OrderByCreatedAsc() PollOptionsQ {
-	q.selector = q.selector.OrderBy("created_at ASC")
+	q.table = q.orderBy(sortKey{Column: "created_at"})
 	return q
 }
 
 func (q PollOptionsQ) OrderByCreatedDesc() PollOptionsQ {
-	q.selector = q.selector.OrderBy("created_at DESC")
+	q.table = q.orderBy(sortKey{Column: "created_at", Desc: true})
 	return q
 }
 
 func (q PollOptionsQ) OrderByVotesDesc() PollOptionsQ {
-	q.selector = q.selector.OrderBy("votes_count DESC")
+	q.table = q.orderBy(sortKey{Column: "votes_count", Desc: true})
 	return q
 }
 
-// ---- Пагинация и count
+// ---- Агрегаты по голосам (approval/score-опросы)
 
-func (q PollOptionsQ) Count(ctx context.Context) (uint64, error) {
-	query, args, err := q.counter.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("building count query for table %s: %w", pollOptionsTable, err)
-	}
-	var c uint64
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&c)
-	} else {
-		err = q.db.QueryRowContext(ctx, query, args...).Scan(&c)
-	}
-	return c, err
+type OptionApprovals struct {
+	OptionID  uuid.UUID `db:"id"`
+	Approvals int       `db:"approvals"`
 }
 
-func (q PollOptionsQ) Page(limit, offset uint64) PollOptionsQ {
-	q.selector = q.selector.Limit(limit).Offset(offset)
-	q.counter = q.counter.Limit(limit).Offset(offset)
-	return q
+type OptionScoreStats struct {
+	OptionID  uuid.UUID `db:"id"`
+	Ratings   int       `db:"ratings"`    // сколько бюллетеней оценили вариант
+	MeanScore float64   `db:"mean_score"` // 0, если оценок нет
+}
+
+type OptionScoreCount struct {
+	OptionID uuid.UUID `db:"option_id"`
+	Score    int       `db:"score"`
+	Votes    int       `db:"votes"`
+}
+
+// ApprovalCounts — число одобрений каждого варианта; совпадает с votes_count, который ведёт триггер.
+func (q PollOptionsQ) ApprovalCounts(ctx context.Context) ([]OptionApprovals, error) {
+	selector := q.selector.RemoveColumns().Columns("id", "votes_count AS approvals")
+	return queryRows[OptionApprovals](ctx, q.db, "approvals", pollOptionsTable, selector)
+}
+
+// ScoreStats — число оценок и средняя оценка каждого варианта.
+func (q PollOptionsQ) ScoreStats(ctx context.Context) ([]OptionScoreStats, error) {
+	selector := q.selector.RemoveColumns().Columns(
+		"id",
+		"(SELECT COUNT(v.score) FROM poll_votes v WHERE v.option_id = poll_options.id) AS ratings",
+		"(SELECT COALESCE(AVG(v.score), 0) FROM poll_votes v WHERE v.option_id = poll_options.id) AS mean_score",
+	)
+	return queryRows[OptionScoreStats](ctx, q.db, "score stats", pollOptionsTable, selector)
+}
+
+// ScoreDistribution — сколько раз каждый вариант получил каждую оценку (нулевые корзины не возвращаются).
+func (q PollOptionsQ) ScoreDistribution(ctx context.Context) ([]OptionScoreCount, error) {
+	// вложенный запрос должен отдавать "?" — нумерацию $n проставит внешний
+	optionIDs := q.selector.RemoveColumns().Columns("id").PlaceholderFormat(sq.Question)
+
+	selector := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("option_id", "score", "COUNT(*) AS votes").
+		From(pollVotesTable).
+		Where(sq.Expr("option_id IN (?)", optionIDs)).
+		Where("score IS NOT NULL").
+		GroupBy("option_id", "score").
+		OrderBy("option_id", "score")
+	return queryRows[OptionScoreCount](ctx, q.db, "score distribution", pollOptionsTable, selector)
+}
+
+// ---- Пагинация
+
+// Page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (q PollOptionsQ) Page(limit uint64, after *Cursor) (PollOptionsQ, error) {
+	var err error
+	q.table, err = q.page(limit, after)
+	return q, err
 }
@@ -0,0 +1,67 @@
+package dbx
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const pollParticipantsTable = "poll_participants"
+
+// PollParticipantUserKey — одно участие пользователя в тайном опросе.
+const PollParticipantUserKey = "poll_participants_poll_id_user_id_key"
+
+// PollParticipant — факт участия пользователя в тайном опросе, без выбранных вариантов.
+type PollParticipant struct {
+	ID        uuid.UUID `db:"id"`
+	PollID    uuid.UUID `db:"poll_id"`
+	UserID    uuid.UUID `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type PollParticipantsQ struct {
+	table[PollParticipant]
+}
+
+func NewPollParticipantsQ(db *sql.DB) PollParticipantsQ {
+	return PollParticipantsQ{newTable[PollParticipant](db, pollParticipantsTable)}
+}
+
+func (q PollParticipantsQ) New() PollParticipantsQ {
+	return NewPollParticipantsQ(q.db)
+}
+
+// Insert — вернёт ошибку при нарушении UNIQUE(poll_id, user_id), т.е. при повторном голосе.
+func (q PollParticipantsQ) Insert(ctx context.Context, in PollParticipant) error {
+	return q.insert(ctx, map[string]interface{}{
+		"id":         in.ID,
+		"poll_id":    in.PollID,
+		"user_id":    in.UserID,
+		"created_at": in.CreatedAt,
+	})
+}
+
+// ---- Filters
+
+func (q PollParticipantsQ) FilterPollID(pollID uuid.UUID) PollParticipantsQ {
+	q.table = q.where(sq.Eq{"poll_id": pollID})
+	return q
+}
+
+func (q PollParticipantsQ) FilterUserID(userID uuid.UUID) PollParticipantsQ {
+	q.table = q.where(sq.Eq{"user_id": userID})
+	return q
+}
+
+// ---- Пагинация
+
+// Page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (q PollParticipantsQ) Page(limit uint64, after *Cursor) (PollParticipantsQ, error) {
+	var err error
+	q.table, err = q.page(limit, after)
+	return q, err
+}
@@ -3,7 +3,6 @@ package dbx
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -12,42 +11,26 @@ import (
 
 const pollVotesTable = "poll_votes"
 
+// PollVoteOptionKey — один голос пользователя за вариант открытого опроса.
+const PollVoteOptionKey = "poll_votes_poll_id_user_id_option_id_key"
+
 type PollVote struct {
-	ID        uuid.UUID `db:"id"`
-	PollID    uuid.UUID `db:"poll_id"`
-	UserID    uuid.UUID `db:"user_id"`
-	OptionID  uuid.UUID `db:"option_id"`
-	CreatedAt time.Time `db:"created_at"`
+	ID        uuid.UUID  `db:"id"`
+	PollID    uuid.UUID  `db:"poll_id"`
+	BallotID  uuid.UUID  `db:"ballot_id"`
+	UserID    *uuid.UUID `db:"user_id"` // NULL в тайных опросах
+	OptionID  uuid.UUID  `db:"option_id"`
+	Rank      *int       `db:"rank"`  // место в бюллетене ranked-опроса; NULL для остальных
+	Score     *int       `db:"score"` // оценка в score-опросе; NULL для остальных
+	CreatedAt time.Time  `db:"created_at"`
 }
 
 type PollVotesQ struct {
-	db       *sql.DB
-	selector sq.SelectBuilder
-	inserter sq.InsertBuilder
-	updater  sq.UpdateBuilder
-	deleter  sq.DeleteBuilder
-	counter  sq.SelectBuilder
+	table[PollVote]
 }
 
 func NewPollVotesQ(db *sql.DB) PollVotesQ {
-	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-
-	selectCols := []string{
-		"id",
-		"poll_id",
-		"user_id",
-		"option_id",
-		"created_at",
-	}
-
-	return PollVotesQ{
-		db:       db,
-		selector: builder.Select(selectCols...).From(pollVotesTable),
-		inserter: builder.Insert(pollVotesTable),
-		updater:  builder.Update(pollVotesTable),
-		deleter:  builder.Delete(pollVotesTable),
-		counter:  builder.Select("COUNT(*) AS count").From(pollVotesTable),
-	}
+	return PollVotesQ{newTable[PollVote](db, pollVotesTable)}
 }
 
 func (q PollVotesQ) New() PollVotesQ {
@@ -59,96 +42,94 @@ func (q PollVotesQ) New() PollVotesQ {
 type InsertPollVoteInput struct {
 	ID        uuid.UUID
 	PollID    uuid.UUID
-	UserID    uuid.UUID
+	BallotID  uuid.UUID
+	UserID    *uuid.UUID
 	OptionID  uuid.UUID
+	Rank      *int
+	Score     *int
 	CreatedAt time.Time
 }
 
 func (q PollVotesQ) Insert(ctx context.Context, in InsertPollVoteInput) error {
-	values := map[string]interface{}{
+	return q.insert(ctx, map[string]interface{}{
 		"id":         in.ID,
 		"poll_id":    in.PollID,
+		"ballot_id":  in.BallotID,
 		"user_id":    in.UserID,
 		"option_id":  in.OptionID,
+		"rank":       in.Rank,
+		"score":      in.Score,
 		"created_at": in.CreatedAt,
-	}
-
-	query, args, err := q.inserter.SetMap(values).ToSql()
-	if err != nil {
-		return fmt.Errorf("building inserter query for table %s: %w", pollVotesTable, err)
-	}
-
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	})
 }
 
-// ---- Read
+// PollChoice — одна строка бюллетеня для InsertBallot/ReplaceSelection.
+type PollChoice struct {
+	OptionID uuid.UUID
+	Rank     *int
+	Score    *int
+}
 
-func (q PollVotesQ) Get(ctx context.Context) (PollVote, error) {
-	query, args, err := q.selector.Limit(1).ToSql()
-	if err != nil {
-		return PollVote{}, fmt.Errorf("building selector query for table %s: %w", pollVotesTable, err)
+// InsertBallot вставляет все строки одного бюллетеня одним запросом под общим ballot_id.
+// Тайные бюллетени идут через InsertAnonymous.
+func (q PollVotesQ) InsertBallot(ctx context.Context, pollID uuid.UUID, userID *uuid.UUID, choices []PollChoice, createdAt time.Time) error {
+	if len(choices) == 0 {
+		return nil
 	}
 
-	var pv PollVote
-	var row *sql.Row
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		row = tx.QueryRowContext(ctx, query, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, query, args...)
+	ballotID := uuid.New()
+	inserter := q.New().inserter.Columns("id", "poll_id", "ballot_id", "user_id", "option_id", "rank", "score", "created_at")
+	for _, c := range choices {
+		inserter = inserter.Values(uuid.New(), pollID, ballotID, userID, c.OptionID, c.Rank, c.Score, createdAt)
 	}
 
-	err = row.Scan(
-		&pv.ID,
-		&pv.PollID,
-		&pv.UserID,
-		&pv.OptionID,
-		&pv.CreatedAt,
-	)
-	return pv, err
+	return exec(ctx, q.db, "inserter", pollVotesTable, inserter)
 }
 
-func (q PollVotesQ) Select(ctx context.Context) ([]PollVote, error) {
-	query, args, err := q.selector.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("building selector query for table %s: %w", pollVotesTable, err)
-	}
+// AnonymousBallot — бюллетень тайного опроса: ни голосующего, ни времени голоса в нём нет.
+type AnonymousBallot struct {
+	PollID  uuid.UUID
+	Choices []PollChoice
+}
 
-	var rows *sql.Rows
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, query, args...)
-	}
-	if err != nil {
-		return nil, err
+// InsertAnonymous вставляет бюллетени тайных опросов одним запросом в переданном порядке,
+// с user_id = NULL и общим created_at. Вызывается вне транзакции голосующего (см. mixer).
+func (q PollVotesQ) InsertAnonymous(ctx context.Context, ballots []AnonymousBallot, createdAt time.Time) error {
+	inserter, ok := q.anonymousInserter(ballots, createdAt)
+	if !ok {
+		return nil
 	}
-	defer rows.Close()
+	return exec(ctx, q.db, "inserter", pollVotesTable, inserter)
+}
 
-	var out []PollVote
-	for rows.Next() {
-		var pv PollVote
-		if err := rows.Scan(
-			&pv.ID,
-			&pv.PollID,
-			&pv.UserID,
-			&pv.OptionID,
-			&pv.CreatedAt,
-		); err != nil {
-			return nil, err
+func (q PollVotesQ) anonymousInserter(ballots []AnonymousBallot, createdAt time.Time) (sq.InsertBuilder, bool) {
+	inserter := q.New().inserter.Columns("id", "poll_id", "ballot_id", "user_id", "option_id", "rank", "score", "created_at")
+	rows := 0
+	for _, b := range ballots {
+		ballotID := uuid.New()
+		for _, c := range b.Choices {
+			inserter = inserter.Values(uuid.New(), b.PollID, ballotID, nil, c.OptionID, c.Rank, c.Score, createdAt)
+			rows++
 		}
-		out = append(out, pv)
 	}
-	return out, nil
+	return inserter, rows > 0
+}
+
+// ReplaceSelection атомарно заменяет весь выбор пользователя в опросе на choices
+// (пустой список — отзыв голоса). Триггер sync_poll_votes_counter отрабатывает на каждую
+// удалённую и вставленную строку, поэтому votes_count остаётся согласованным.
+func (q PollVotesQ) ReplaceSelection(ctx context.Context, pollID, userID uuid.UUID, choices []PollChoice, createdAt time.Time) error {
+	return Transaction(ctx, q.db, func(ctx context.Context) error {
+		if err := q.New().FilterPollID(pollID).FilterUserID(userID).Delete(ctx); err != nil {
+			return err
+		}
+		return q.New().InsertBallot(ctx, pollID, &userID, choices, createdAt)
+	})
 }
 
 // ---- Update
 // Разрешаем менять option_id и/или poll_id. created_at не трогаем.
-// NB: действует UNIQUE(poll_id, user_id) — при смене poll_id возможен конфликт.
+// NB: действует UNIQUE(poll_id, user_id, option_id) — при смене option_id возможен конфликт.
 type UpdatePollVoteInput struct {
 	OptionID *uuid.UUID
 }
@@ -158,102 +139,53 @@ func (q PollVotesQ) Update(ctx context.Context, in UpdatePollVoteInput) error {
 	if in.OptionID != nil {
 		updates["option_id"] = *in.OptionID
 	}
-	if len(updates) == 0 {
-		return nil
-	}
-
-	query, args, err := q.updater.SetMap(updates).ToSql()
-	if err != nil {
-		return fmt.Errorf("building updater query for table %s: %w", pollVotesTable, err)
-	}
-
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
-}
-
-// ---- Delete
-
-func (q PollVotesQ) Delete(ctx context.Context) error {
-	query, args, err := q.deleter.ToSql()
-	if err != nil {
-		return fmt.Errorf("building deleter query for table %s: %w", pollVotesTable, err)
-	}
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	return q.update(ctx, updates)
 }
 
 // ---- Filters
 
 func (q PollVotesQ) FilterID(id uuid.UUID) PollVotesQ {
-	q.selector = q.selector.Where(sq.Eq{"id": id})
-	q.counter = q.counter.Where(sq.Eq{"id": id})
-	q.updater = q.updater.Where(sq.Eq{"id": id})
-	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.table = q.where(sq.Eq{"id": id})
 	return q
 }
 
 func (q PollVotesQ) FilterPollID(pollID uuid.UUID) PollVotesQ {
-	q.selector = q.selector.Where(sq.Eq{"poll_id": pollID})
-	q.counter = q.counter.Where(sq.Eq{"poll_id": pollID})
-	q.updater = q.updater.Where(sq.Eq{"poll_id": pollID})
-	q.deleter = q.deleter.Where(sq.Eq{"poll_id": pollID})
+	q.table = q.where(sq.Eq{"poll_id": pollID})
 	return q
 }
 
 func (q PollVotesQ) FilterUserID(userID uuid.UUID) PollVotesQ {
-	q.selector = q.selector.Where(sq.Eq{"user_id": userID})
-	q.counter = q.counter.Where(sq.Eq{"user_id": userID})
-	q.updater = q.updater.Where(sq.Eq{"user_id": userID})
-	q.deleter = q.deleter.Where(sq.Eq{"user_id": userID})
+	q.table = q.where(sq.Eq{"user_id": userID})
 	return q
 }
 
 func (q PollVotesQ) FilterOptionID(optionID uuid.UUID) PollVotesQ {
-	q.selector = q.selector.Where(sq.Eq{"option_id": optionID})
-	q.counter = q.counter.Where(sq.Eq{"option_id": optionID})
-	q.updater = q.updater.Where(sq.Eq{"option_id": optionID})
-	q.deleter = q.deleter.Where(sq.Eq{"option_id": optionID})
+	q.table = q.where(sq.Eq{"option_id": optionID})
 	return q
 }
 
 // ---- Сортировки и пагинация
 
 func (q PollVotesQ) OrderByCreatedAsc() PollVotesQ {
-	q.selector = q.selector.OrderBy("created_at ASC")
+	q.table = q.orderBy(sortKey{Column: "created_at"})
 	return q
 }
 
 func (q PollVotesQ) OrderByCreatedDesc() PollVotesQ {
-	q.selector = q.selector.OrderBy("created_at DESC")
+	q.table = q.orderBy(sortKey{Column: "created_at", Desc: true})
 	return q
 }
 
-func (q PollVotesQ) Page(limit, offset uint64) PollVotesQ {
-	q.selector = q.selector.Limit(limit).Offset(offset)
-	q.counter = q.counter.Limit(limit).Offset(offset)
+// OrderByBallot группирует строки по бюллетеням и упорядочивает их по месту внутри бюллетеня.
+func (q PollVotesQ) OrderByBallot() PollVotesQ {
+	q.selector = q.selector.OrderBy("ballot_id ASC", "rank ASC")
 	return q
 }
 
-// ---- Count
-
-func (q PollVotesQ) Count(ctx context.Context) (uint64, error) {
-	query, args, err := q.counter.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("building count query for table %s: %w", pollVotesTable, err)
-	}
-	var c uint64
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&c)
-	} else {
-		err = q.db.QueryRowContext(ctx, query, args...).Scan(&c)
-	}
-	return c, err
+// Page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (q PollVotesQ) Page(limit uint64, after *Cursor) (PollVotesQ, error) {
+	var err error
+	q.table, err = q.page(limit, after)
+	return q, err
 }
@@ -0,0 +1,80 @@
+package dbx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Тайный бюллетень не должен нести ничего, что связывает его с записью poll_participants:
+// ни user_id, ни точного времени, ни порядка поступления.
+func TestAnonymousBallotsCarryNoVoterLink(t *testing.T) {
+	pollID := uuid.New()
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	rank1, rank2 := 1, 2
+
+	ballots := []AnonymousBallot{
+		{PollID: pollID, Choices: []PollChoice{{OptionID: uuid.New(), Rank: &rank1}, {OptionID: uuid.New(), Rank: &rank2}}},
+		{PollID: pollID, Choices: []PollChoice{{OptionID: uuid.New(), Rank: &rank1}}},
+		{PollID: pollID, Choices: []PollChoice{{OptionID: uuid.New(), Rank: &rank1}}},
+	}
+
+	inserter, ok := NewPollVotesQ(nil).anonymousInserter(ballots, day)
+	if !ok {
+		t.Fatal("no rows built")
+	}
+	query, args, err := inserter.ToSql()
+	if err != nil {
+		t.Fatalf("ToSql: %v", err)
+	}
+
+	// колонки poll_participants, кроме poll_id, в запросе не встречаются
+	if !strings.HasPrefix(query, "INSERT INTO poll_votes (id,poll_id,ballot_id,user_id,option_id,rank,score,created_at) VALUES") {
+		t.Fatalf("unexpected statement: %s", query)
+	}
+
+	const cols = 8
+	if len(args) != 4*cols {
+		t.Fatalf("want 4 rows, got %d args", len(args))
+	}
+
+	ballotIDs := map[uuid.UUID]int{}
+	for row := 0; row < len(args)/cols; row++ {
+		r := args[row*cols : (row+1)*cols]
+		if r[3] != nil {
+			t.Fatalf("row %d: user_id = %v, want NULL", row, r[3])
+		}
+		if r[7] != day {
+			t.Fatalf("row %d: created_at = %v, want the shared %v", row, r[7], day)
+		}
+		ballotIDs[r[2].(uuid.UUID)]++
+	}
+
+	// ballot_id случайный и свой у каждого бюллетеня, строки одного бюллетеня — под одним id
+	if len(ballotIDs) != len(ballots) {
+		t.Fatalf("want %d ballot ids, got %d", len(ballots), len(ballotIDs))
+	}
+	for id := range ballotIDs {
+		if id.Version() != 4 {
+			t.Fatalf("ballot id %s is not random (version %d)", id, id.Version())
+		}
+	}
+
+	// строки идут в порядке батча, который перемешал миксер, а не в порядке голосования
+	for row, want := range []uuid.UUID{
+		ballots[0].Choices[0].OptionID, ballots[0].Choices[1].OptionID,
+		ballots[1].Choices[0].OptionID, ballots[2].Choices[0].OptionID,
+	} {
+		if got := args[row*cols+4]; got != want {
+			t.Fatalf("row %d: option %v, want %v", row, got, want)
+		}
+	}
+}
+
+func TestAnonymousBallotsEmpty(t *testing.T) {
+	if _, ok := NewPollVotesQ(nil).anonymousInserter(nil, time.Now()); ok {
+		t.Fatal("empty batch must not build a statement")
+	}
+}
@@ -3,7 +3,6 @@ package dbx
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -18,50 +17,31 @@ type Poll struct {
 	Title       string    `db:"title"`
 	Description string    `db:"description"`
 	Status      string    `db:"status"` // poll_status
+	Type        string    `db:"type"`   // poll_type
+	Secret      bool      `db:"secret"` // голоса хранятся без user_id
 	InitiatorID uuid.UUID `db:"initiator_id"`
+	MinChoices  int       `db:"min_choices"`
+	MaxChoices  int       `db:"max_choices"`
+	MaxScore    int       `db:"max_score"` // верхняя граница оценки в score-опросах
 	EndDate     time.Time `db:"end_date"`
 	CreatedAt   time.Time `db:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"`
 
 	// Гео, прочитанное как lat/lng; если location NULL — поля будут nil
-	Lat *float64 `db:"lat"`
-	Lng *float64 `db:"lng"`
+	Lat *float64 `db:"lat" expr:"ST_Y(location)"`
+	Lng *float64 `db:"lng" expr:"ST_X(location)"`
+
+	// Заполняются только в выборке с Search
+	Rank    float32 `db:"rank,optional"`
+	Snippet string  `db:"snippet,optional"`
 }
 
 type PollsQ struct {
-	db       *sql.DB
-	selector sq.SelectBuilder
-	inserter sq.InsertBuilder
-	updater  sq.UpdateBuilder
-	deleter  sq.DeleteBuilder
-	counter  sq.SelectBuilder
+	table[Poll]
 }
 
 func NewPollsQ(db *sql.DB) PollsQ {
-	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-
-	selectCols := []string{
-		"id",
-		"city_id",
-		"title",
-		"description",
-		"status",
-		"initiator_id",
-		"end_date",
-		"created_at",
-		"updated_at",
-		"ST_Y(location) AS lat",
-		"ST_X(location) AS lng",
-	}
-
-	return PollsQ{
-		db:       db,
-		selector: builder.Select(selectCols...).From(pollsTable),
-		inserter: builder.Insert(pollsTable),
-		updater:  builder.Update(pollsTable),
-		deleter:  builder.Delete(pollsTable),
-		counter:  builder.Select("COUNT(*) AS count").From(pollsTable),
-	}
+	return PollsQ{newTable[Poll](db, pollsTable)}
 }
 
 func (q PollsQ) New() PollsQ { return NewPollsQ(q.db) }
@@ -74,7 +54,12 @@ type InsertPollInput struct {
 	Title       string
 	Description string
 	Status      string
+	Type        string
+	Secret      bool
 	InitiatorID uuid.UUID
+	MinChoices  int
+	MaxChoices  int
+	MaxScore    int
 	EndDate     time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -82,40 +67,43 @@ type InsertPollInput struct {
 }
 
 func (q PollsQ) Insert(ctx context.Context, in InsertPollInput) error {
-	values := map[string]interface{}{
+	return q.insert(ctx, map[string]interface{}{
 		"id":           in.ID,
 		"city_id":      in.CityID,
 		"title":        in.Title,
 		"description":  in.Description,
 		"status":       in.Status,
+		"type":         in.Type,
+		"secret":       in.Secret,
 		"initiator_id": in.InitiatorID,
+		"min_choices":  in.MinChoices,
+		"max_choices":  in.MaxChoices,
+		"max_score":    in.MaxScore,
 		"end_date":     in.EndDate,
 		"created_at":   in.CreatedAt,
 		"updated_at":   in.UpdatedAt,
-	}
-	if in.Location != nil {
-		values["location"] = sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", in.Location.Lng, in.Location.Lat)
-	} else {
-		values["location"] = nil
-	}
-
-	query, args, err := q.inserter.SetMap(values).ToSql()
-	if err != nil {
-		return fmt.Errorf("building inserter query for table %s: %w", pollsTable, err)
-	}
+		"location":     geoPoint(in.Location),
+	})
+}
 
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+// InsertWithOptions атомарно вставляет опрос и его варианты (одним многострочным INSERT);
+// внутри внешней транзакции работает через savepoint.
+func (q PollsQ) InsertWithOptions(ctx context.Context, in InsertPollInput, options []InsertPollOptionInput) error {
+	return Transaction(ctx, q.db, func(ctx context.Context) error {
+		if err := q.New().Insert(ctx, in); err != nil {
+			return err
+		}
+		return NewPollOptionsQ(q.db).InsertMany(ctx, options)
+	})
 }
 
 type UpdatePollInput struct {
 	Title       *string
 	Description *string
 	Status      *string
+	MinChoices  *int
+	MaxChoices  *int
+	MaxScore    *int
 	EndDate     *time.Time
 	UpdatedAt   *time.Time
 	Location    *GeoPoint // nil => не менять
@@ -133,6 +121,15 @@ func (q PollsQ) Update(ctx context.Context, in UpdatePollInput) error {
 	if in.Status != nil {
 		updates["status"] = *in.Status
 	}
+	if in.MinChoices != nil {
+		updates["min_choices"] = *in.MinChoices
+	}
+	if in.MaxChoices != nil {
+		updates["max_choices"] = *in.MaxChoices
+	}
+	if in.MaxScore != nil {
+		updates["max_score"] = *in.MaxScore
+	}
 	if in.EndDate != nil {
 		updates["end_date"] = *in.EndDate
 	}
@@ -140,186 +137,92 @@ func (q PollsQ) Update(ctx context.Context, in UpdatePollInput) error {
 		updates["updated_at"] = *in.UpdatedAt
 	}
 	if in.Location != nil {
-		updates["location"] = sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", in.Location.Lng, in.Location.Lat)
-	}
-
-	if len(updates) == 0 {
-		return nil
-	}
-
-	query, args, err := q.updater.SetMap(updates).ToSql()
-	if err != nil {
-		return fmt.Errorf("building updater query for table %s: %w", pollsTable, err)
-	}
-
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
-}
-
-func (q PollsQ) Delete(ctx context.Context) error {
-	query, args, err := q.deleter.ToSql()
-	if err != nil {
-		return fmt.Errorf("building deleter query for table %s: %w", pollsTable, err)
-	}
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
-}
-
-// ---------- Queries
-
-func (q PollsQ) Get(ctx context.Context) (Poll, error) {
-	query, args, err := q.selector.Limit(1).ToSql()
-	if err != nil {
-		return Poll{}, fmt.Errorf("building selector query for table %s: %w", pollsTable, err)
+		updates["location"] = geoPoint(in.Location)
 	}
 
-	var m Poll
-	var row *sql.Row
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		row = tx.QueryRowContext(ctx, query, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, query, args...)
-	}
-
-	err = row.Scan(
-		&m.ID,
-		&m.CityID,
-		&m.Title,
-		&m.Description,
-		&m.Status,
-		&m.InitiatorID,
-		&m.EndDate,
-		&m.CreatedAt,
-		&m.UpdatedAt,
-		&m.Lat,
-		&m.Lng,
-	)
-	return m, err
-}
-
-func (q PollsQ) Select(ctx context.Context) ([]Poll, error) {
-	query, args, err := q.selector.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("building selector query for table %s: %w", pollsTable, err)
-	}
-
-	var rows *sql.Rows
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var out []Poll
-	for rows.Next() {
-		var m Poll
-		if err := rows.Scan(
-			&m.ID,
-			&m.CityID,
-			&m.Title,
-			&m.Description,
-			&m.Status,
-			&m.InitiatorID,
-			&m.EndDate,
-			&m.CreatedAt,
-			&m.UpdatedAt,
-			&m.Lat,
-			&m.Lng,
-		); err != nil {
-			return nil, err
-		}
-		out = append(out, m)
-	}
-	return out, nil
+	return q.update(ctx, updates)
 }
 
 // ---------- Filters
 
 func (q PollsQ) FilterID(id uuid.UUID) PollsQ {
-	q.selector = q.selector.Where(sq.Eq{"id": id})
-	q.counter = q.counter.Where(sq.Eq{"id": id})
-	q.updater = q.updater.Where(sq.Eq{"id": id})
-	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.table = q.where(sq.Eq{"id": id})
 	return q
 }
 
 func (q PollsQ) FilterCityID(cityID uuid.UUID) PollsQ {
-	q.selector = q.selector.Where(sq.Eq{"city_id": cityID})
-	q.counter = q.counter.Where(sq.Eq{"city_id": cityID})
-	q.updater = q.updater.Where(sq.Eq{"city_id": cityID})
-	q.deleter = q.deleter.Where(sq.Eq{"city_id": cityID})
+	q.table = q.where(sq.Eq{"city_id": cityID})
 	return q
 }
 
 func (q PollsQ) FilterInitiatorID(initiatorID uuid.UUID) PollsQ {
-	q.selector = q.selector.Where(sq.Eq{"initiator_id": initiatorID})
-	q.counter = q.counter.Where(sq.Eq{"initiator_id": initiatorID})
-	q.updater = q.updater.Where(sq.Eq{"initiator_id": initiatorID})
-	q.deleter = q.deleter.Where(sq.Eq{"initiator_id": initiatorID})
+	q.table = q.where(sq.Eq{"initiator_id": initiatorID})
 	return q
 }
 
 func (q PollsQ) FilterStatus(status string) PollsQ {
-	q.selector = q.selector.Where(sq.Eq{"status": status})
-	q.counter = q.counter.Where(sq.Eq{"status": status})
-	q.updater = q.updater.Where(sq.Eq{"status": status})
-	q.deleter = q.deleter.Where(sq.Eq{"status": status})
+	q.table = q.where(sq.Eq{"status": status})
+	return q
+}
+
+// FilterEndDateBefore — записи, у которых end_date уже наступил к моменту t.
+func (q PollsQ) FilterEndDateBefore(t time.Time) PollsQ {
+	q.table = q.where(sq.LtOrEq{"end_date": t})
+	return q
+}
+
+// ForUpdate блокирует выбранные строки до конца транзакции (имеет смысл только внутри Transaction).
+func (q PollsQ) ForUpdate() PollsQ {
+	q.table = q.forUpdate()
 	return q
 }
 
 func (q PollsQ) TitleLike(s string) PollsQ {
-	p := fmt.Sprintf("%%%s%%", s)
-	q.selector = q.selector.Where("title ILIKE ?", p)
-	q.counter = q.counter.Where("title ILIKE ?", p)
+	q.table = q.titleLike(s)
 	return q
 }
 
-// Гео-фильтры (как в petitions)
+// Search — полнотекстовый поиск по search_vector; без configs запрос разбирается всеми SearchConfigs.
+// Заполняет Rank и Snippet.
+func (q PollsQ) Search(text string, configs ...string) PollsQ {
+	q.table = q.search(text, configs)
+	return q
+}
 
 func (q PollsQ) BBox(minLng, minLat, maxLng, maxLat float64) PollsQ {
-	env := sq.Expr("ST_MakeEnvelope(?, ?, ?, ?, 4326)", minLng, minLat, maxLng, maxLat)
-	q.selector = q.selector.Where(sq.Expr("location IS NOT NULL AND ST_Intersects(location, ?)", env))
-	q.counter = q.counter.Where(sq.Expr("location IS NOT NULL AND ST_Intersects(location, ?)", env))
+	q.table = q.bbox(minLng, minLat, maxLng, maxLat)
 	return q
 }
 
 func (q PollsQ) WithinRadius(lng, lat, radiusMeters float64) PollsQ {
-	pt := sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", lng, lat)
-	q.selector = q.selector.Where(sq.Expr("location IS NOT NULL AND ST_DWithin(location::geography, (? )::geography, ?)", pt, radiusMeters))
-	q.counter = q.counter.Where(sq.Expr("location IS NOT NULL AND ST_DWithin(location::geography, (? )::geography, ?)", pt, radiusMeters))
+	q.table = q.withinRadius(lng, lat, radiusMeters)
 	return q
 }
 
-// ---------- Pagination
+// ---------- Ordering
 
-func (q PollsQ) Count(ctx context.Context) (uint64, error) {
-	query, args, err := q.counter.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("building count query for table %s: %w", pollsTable, err)
-	}
-	var c uint64
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&c)
-	} else {
-		err = q.db.QueryRowContext(ctx, query, args...).Scan(&c)
-	}
-	return c, err
+func (q PollsQ) OrderByCreatedAsc() PollsQ {
+	q.table = q.orderBy(sortKey{Column: "created_at"})
+	return q
 }
 
-func (q PollsQ) Page(limit, offset uint64) PollsQ {
-	q.selector = q.selector.Limit(limit).Offset(offset)
-	q.counter = q.counter.Limit(limit).Offset(offset)
+func (q PollsQ) OrderByCreatedDesc() PollsQ {
+	q.table = q.orderBy(sortKey{Column: "created_at", Desc: true})
 	return q
 }
+
+// OrderByRank — сначала самые релевантные; только вместе с Search.
+func (q PollsQ) OrderByRank() PollsQ {
+	q.table = q.orderBy(rankSort)
+	return q
+}
+
+// ---------- Pagination
+
+// Page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (q PollsQ) Page(limit uint64, after *Cursor) (PollsQ, error) {
+	var err error
+	q.table, err = q.page(limit, after)
+	return q, err
+}
@@ -3,7 +3,6 @@ package dbx
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -12,12 +11,6 @@ import (
 
 const proposalsTable = "proposals"
 
-// GeoPoint уже есть у тебя рядом с Petitions; если нет — раскомментируй.
-// type GeoPoint struct {
-// 	Lat float64
-// 	Lng float64
-// }
-
 type Proposal struct {
 	ID           uuid.UUID  `db:"id"`
 	CityID       uuid.UUID  `db:"city_id"`
@@ -32,48 +25,27 @@ type Proposal struct {
 	CreatedAt    time.Time  `db:"created_at"`
 	UpdatedAt    time.Time  `db:"updated_at"`
 
-	// извлекаемыe координаты; если location NULL — будут nil
-	Lat *float64 `db:"lat"`
-	Lng *float64 `db:"lng"`
+	// Итог рассмотрения; NULL, пока адресат не вынес решение
+	DecidedBy      *uuid.UUID `db:"decided_by"`
+	DecidedAt      *time.Time `db:"decided_at"`
+	FinalAgreed    *int       `db:"final_agreed"`
+	FinalDisagreed *int       `db:"final_disagreed"`
+
+	// извлекаемые координаты; если location NULL — будут nil
+	Lat *float64 `db:"lat" expr:"ST_Y(location)"`
+	Lng *float64 `db:"lng" expr:"ST_X(location)"`
+
+	// Заполняются только в выборке с Search
+	Rank    float32 `db:"rank,optional"`
+	Snippet string  `db:"snippet,optional"`
 }
 
 type ProposalsQ struct {
-	db       *sql.DB
-	selector sq.SelectBuilder
-	inserter sq.InsertBuilder
-	updater  sq.UpdateBuilder
-	deleter  sq.DeleteBuilder
-	counter  sq.SelectBuilder
+	table[Proposal]
 }
 
 func NewProposalsQ(db *sql.DB) ProposalsQ {
-	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-
-	selectCols := []string{
-		"id",
-		"city_id",
-		"title",
-		"description",
-		"status",
-		"initiator_id",
-		"address_to_id",
-		"agreed_num",
-		"disagreed_num",
-		"end_date",
-		"created_at",
-		"updated_at",
-		"ST_Y(location) AS lat",
-		"ST_X(location) AS lng",
-	}
-
-	return ProposalsQ{
-		db:       db,
-		selector: builder.Select(selectCols...).From(proposalsTable),
-		inserter: builder.Insert(proposalsTable),
-		updater:  builder.Update(proposalsTable),
-		deleter:  builder.Delete(proposalsTable),
-		counter:  builder.Select("COUNT(*) AS count").From(proposalsTable),
-	}
+	return ProposalsQ{newTable[Proposal](db, proposalsTable)}
 }
 
 func (q ProposalsQ) New() ProposalsQ {
@@ -98,7 +70,7 @@ type InsertProposalInput struct {
 }
 
 func (q ProposalsQ) Insert(ctx context.Context, in InsertProposalInput) error {
-	values := map[string]interface{}{
+	return q.insert(ctx, map[string]interface{}{
 		"id":            in.ID,
 		"city_id":       in.CityID,
 		"title":         in.Title,
@@ -109,102 +81,8 @@ func (q ProposalsQ) Insert(ctx context.Context, in InsertProposalInput) error {
 		"end_date":      in.EndDate,
 		"created_at":    in.CreatedAt,
 		"updated_at":    in.UpdatedAt,
-	}
-
-	if in.Location != nil {
-		values["location"] = sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", in.Location.Lng, in.Location.Lat)
-	} else {
-		values["location"] = nil
-	}
-
-	query, args, err := q.inserter.SetMap(values).ToSql()
-	if err != nil {
-		return fmt.Errorf("building inserter query for table %s: %w", proposalsTable, err)
-	}
-
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
-}
-
-// -------- Read
-
-func (q ProposalsQ) Get(ctx context.Context) (Proposal, error) {
-	query, args, err := q.selector.Limit(1).ToSql()
-	if err != nil {
-		return Proposal{}, fmt.Errorf("building selector query for table %s: %w", proposalsTable, err)
-	}
-
-	var m Proposal
-	var row *sql.Row
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		row = tx.QueryRowContext(ctx, query, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&m.ID,
-		&m.CityID,
-		&m.Title,
-		&m.Description,
-		&m.Status,
-		&m.InitiatorID,
-		&m.AddressToID,
-		&m.AgreedNum,
-		&m.DisagreedNum,
-		&m.EndDate,
-		&m.CreatedAt,
-		&m.UpdatedAt,
-		&m.Lat,
-		&m.Lng,
-	)
-	return m, err
-}
-
-func (q ProposalsQ) Select(ctx context.Context) ([]Proposal, error) {
-	query, args, err := q.selector.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("building selector query for table %s: %w", proposalsTable, err)
-	}
-
-	var rows *sql.Rows
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var out []Proposal
-	for rows.Next() {
-		var m Proposal
-		if err := rows.Scan(
-			&m.ID,
-			&m.CityID,
-			&m.Title,
-			&m.Description,
-			&m.Status,
-			&m.InitiatorID,
-			&m.AddressToID,
-			&m.AgreedNum,
-			&m.DisagreedNum,
-			&m.EndDate,
-			&m.CreatedAt,
-			&m.UpdatedAt,
-			&m.Lat,
-			&m.Lng,
-		); err != nil {
-			return nil, err
-		}
-		out = append(out, m)
-	}
-	return out, nil
+		"location":      geoPoint(in.Location),
+	})
 }
 
 // -------- Update (без изменения agreed/disagreed)
@@ -217,6 +95,11 @@ type UpdateProposalInput struct {
 	EndDate     *time.Time
 	UpdatedAt   *time.Time
 	Location    *GeoPoint // nil -> не менять
+
+	DecidedBy *uuid.UUID
+	DecidedAt *time.Time
+	// FreezeTally копирует текущие agreed_num/disagreed_num в final_* тем же запросом
+	FreezeTally bool
 }
 
 func (q ProposalsQ) Update(ctx context.Context, in UpdateProposalInput) error {
@@ -241,155 +124,124 @@ func (q ProposalsQ) Update(ctx context.Context, in UpdateProposalInput) error {
 		updates["updated_at"] = *in.UpdatedAt
 	}
 	if in.Location != nil {
-		updates["location"] = sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", in.Location.Lng, in.Location.Lat)
+		updates["location"] = geoPoint(in.Location)
 	}
-
-	if len(updates) == 0 {
-		return nil
+	if in.DecidedBy != nil {
+		updates["decided_by"] = *in.DecidedBy
 	}
-
-	query, args, err := q.updater.SetMap(updates).ToSql()
-	if err != nil {
-		return fmt.Errorf("building updater query for table %s: %w", proposalsTable, err)
+	if in.DecidedAt != nil {
+		updates["decided_at"] = *in.DecidedAt
 	}
-
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
+	if in.FreezeTally {
+		updates["final_agreed"] = sq.Expr("agreed_num")
+		updates["final_disagreed"] = sq.Expr("disagreed_num")
 	}
-	return err
-}
-
-// -------- Delete
 
-func (q ProposalsQ) Delete(ctx context.Context) error {
-	query, args, err := q.deleter.ToSql()
-	if err != nil {
-		return fmt.Errorf("building deleter query for table %s: %w", proposalsTable, err)
-	}
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	return q.update(ctx, updates)
 }
 
 // -------- Фильтры
 
 func (q ProposalsQ) FilterID(id uuid.UUID) ProposalsQ {
-	q.selector = q.selector.Where(sq.Eq{"id": id})
-	q.counter = q.counter.Where(sq.Eq{"id": id})
-	q.updater = q.updater.Where(sq.Eq{"id": id})
-	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.table = q.where(sq.Eq{"id": id})
 	return q
 }
 
 func (q ProposalsQ) FilterCityID(cityID uuid.UUID) ProposalsQ {
-	q.selector = q.selector.Where(sq.Eq{"city_id": cityID})
-	q.counter = q.counter.Where(sq.Eq{"city_id": cityID})
-	q.updater = q.updater.Where(sq.Eq{"city_id": cityID})
-	q.deleter = q.deleter.Where(sq.Eq{"city_id": cityID})
+	q.table = q.where(sq.Eq{"city_id": cityID})
 	return q
 }
 
 func (q ProposalsQ) FilterInitiatorID(initiatorID uuid.UUID) ProposalsQ {
-	q.selector = q.selector.Where(sq.Eq{"initiator_id": initiatorID})
-	q.counter = q.counter.Where(sq.Eq{"initiator_id": initiatorID})
-	q.updater = q.updater.Where(sq.Eq{"initiator_id": initiatorID})
-	q.deleter = q.deleter.Where(sq.Eq{"initiator_id": initiatorID})
+	q.table = q.where(sq.Eq{"initiator_id": initiatorID})
 	return q
 }
 
 func (q ProposalsQ) FilterStatus(status string) ProposalsQ {
-	q.selector = q.selector.Where(sq.Eq{"status": status})
-	q.counter = q.counter.Where(sq.Eq{"status": status})
-	q.updater = q.updater.Where(sq.Eq{"status": status})
-	q.deleter = q.deleter.Where(sq.Eq{"status": status})
+	q.table = q.where(sq.Eq{"status": status})
 	return q
 }
 
 func (q ProposalsQ) FilterAddressedTo(addressToID uuid.UUID) ProposalsQ {
-	q.selector = q.selector.Where(sq.Eq{"address_to_id": addressToID})
-	q.counter = q.counter.Where(sq.Eq{"address_to_id": addressToID})
-	q.updater = q.updater.Where(sq.Eq{"address_to_id": addressToID})
-	q.deleter = q.deleter.Where(sq.Eq{"address_to_id": addressToID})
+	q.table = q.where(sq.Eq{"address_to_id": addressToID})
 	return q
 }
 
 func (q ProposalsQ) FilterAddressedToCityGov() ProposalsQ {
-	// address_to_id IS NULL
-	q.selector = q.selector.Where("address_to_id IS NULL")
-	q.counter = q.counter.Where("address_to_id IS NULL")
-	q.updater = q.updater.Where("address_to_id IS NULL")
-	q.deleter = q.deleter.Where("address_to_id IS NULL")
+	q.table = q.where("address_to_id IS NULL")
+	return q
+}
+
+// FilterEndDateBefore — записи, у которых end_date уже наступил к моменту t.
+func (q ProposalsQ) FilterEndDateBefore(t time.Time) ProposalsQ {
+	q.table = q.where(sq.LtOrEq{"end_date": t})
+	return q
+}
+
+// ForUpdate блокирует выбранные строки до конца транзакции (имеет смысл только внутри Transaction).
+func (q ProposalsQ) ForUpdate() ProposalsQ {
+	q.table = q.forUpdate()
 	return q
 }
 
 func (q ProposalsQ) TitleLike(s string) ProposalsQ {
-	p := fmt.Sprintf("%%%s%%", s)
-	q.selector = q.selector.Where("title ILIKE ?", p)
-	q.counter = q.counter.Where("title ILIKE ?", p)
+	q.table = q.titleLike(s)
 	return q
 }
 
-// -------- Геофильтры
+// Search — полнотекстовый поиск по search_vector; без configs запрос разбирается всеми SearchConfigs.
+// Заполняет Rank и Snippet.
+func (q ProposalsQ) Search(text string, configs ...string) ProposalsQ {
+	q.table = q.search(text, configs)
+	return q
+}
+
+// -------- Геофильтры (PostGIS)
 
 func (q ProposalsQ) BBox(minLng, minLat, maxLng, maxLat float64) ProposalsQ {
-	env := sq.Expr("ST_MakeEnvelope(?, ?, ?, ?, 4326)", minLng, minLat, maxLng, maxLat)
-	q.selector = q.selector.Where(sq.Expr("location IS NOT NULL AND ST_Intersects(location, ?)", env))
-	q.counter = q.counter.Where(sq.Expr("location IS NOT NULL AND ST_Intersects(location, ?)", env))
+	q.table = q.bbox(minLng, minLat, maxLng, maxLat)
 	return q
 }
 
 func (q ProposalsQ) WithinRadius(lng, lat, radiusMeters float64) ProposalsQ {
-	pt := sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", lng, lat)
-	q.selector = q.selector.Where(sq.Expr("location IS NOT NULL AND ST_DWithin(location::geography, (? )::geography, ?)", pt, radiusMeters))
-	q.counter = q.counter.Where(sq.Expr("location IS NOT NULL AND ST_DWithin(location::geography, (? )::geography, ?)", pt, radiusMeters))
+	q.table = q.withinRadius(lng, lat, radiusMeters)
 	return q
 }
 
-// -------- Сортировки
+// -------- Сортировка
 
 func (q ProposalsQ) OrderByCreatedAsc() ProposalsQ {
-	q.selector = q.selector.OrderBy("created_at ASC")
+	q.table = q.orderBy(sortKey{Column: "created_at"})
 	return q
 }
 
 func (q ProposalsQ) OrderByCreatedDesc() ProposalsQ {
-	q.selector = q.selector.OrderBy("created_at DESC")
+	q.table = q.orderBy(sortKey{Column: "created_at", Desc: true})
 	return q
 }
 
-func (q ProposalsQ) OrderByAgreedDesc() ProposalsQ {
-	q.selector = q.selector.OrderBy("agreed_num DESC")
+// OrderByRank — сначала самые релевантные; только вместе с Search.
+func (q ProposalsQ) OrderByRank() ProposalsQ {
+	q.table = q.orderBy(rankSort)
 	return q
 }
 
-func (q ProposalsQ) OrderByDisagreedDesc() ProposalsQ {
-	q.selector = q.selector.OrderBy("disagreed_num DESC")
+func (q ProposalsQ) OrderByAgreedDesc() ProposalsQ {
+	q.table = q.orderBy(sortKey{Column: "agreed_num", Desc: true})
 	return q
 }
 
-// -------- Пагинация и Count
-
-func (q ProposalsQ) Page(limit, offset uint64) ProposalsQ {
-	q.selector = q.selector.Limit(limit).Offset(offset)
-	q.counter = q.counter.Limit(limit).Offset(offset)
+func (q ProposalsQ) OrderByDisagreedDesc() ProposalsQ {
+	q.table = q.orderBy(sortKey{Column: "disagreed_num", Desc: true})
 	return q
 }
 
-func (q ProposalsQ) Count(ctx context.Context) (uint64, error) {
-	query, args, err := q.counter.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("building count query for table %s: %w", proposalsTable, err)
-	}
-	var c uint64
-	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
-		err = tx.QueryRowContext(ctx, query, args...).Scan(&c)
-	} else {
-		err = q.db.QueryRowContext(ctx, query, args...).Scan(&c)
-	}
-	return c, err
+// -------- Пагинация
+
+// Page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (q ProposalsQ) Page(limit uint64, after *Cursor) (ProposalsQ, error) {
+	var err error
+	q.table, err = q.page(limit, after)
+	return q, err
 }
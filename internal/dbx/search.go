@@ -0,0 +1,78 @@
+package dbx
+
+import (
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Text search configurations the search_vector columns are built with. Stock Postgres has no Ukrainian
+// dictionary, so ukrainian (migration 014) is a copy of simple: Ukrainian words are lower-cased but not
+// stemmed and match only in the same form. A real dictionary can later be plugged into it with
+// ALTER TEXT SEARCH CONFIGURATION without touching the columns.
+const (
+	SearchRussian   = "russian"
+	SearchUkrainian = "ukrainian"
+	SearchEnglish   = "english"
+)
+
+// SearchConfigs are all configurations of search_vector, in the order they are tried for snippets.
+var SearchConfigs = []string{SearchRussian, SearchUkrainian, SearchEnglish}
+
+const (
+	ftsMatch = "search_vector @@ fts.query"
+	ftsRank  = "ts_rank(search_vector, fts.query)"
+)
+
+// rankSort — по релевантности; значение курсора берётся из колонки rank.
+var rankSort = sortKey{Column: ftsRank, Desc: true, Field: "rank"}
+
+// ftsJoin — однострочный подзапрос с разобранным запросом пользователя; tsquery по каждой
+// конфигурации объединяются через OR, строки таблицы join не размножает.
+func ftsJoin(text string, configs []string) sq.Sqlizer {
+	parts := make([]string, 0, len(configs))
+	args := make([]interface{}, 0, 2*len(configs))
+	for _, cfg := range configs {
+		parts = append(parts, "websearch_to_tsquery(?::regconfig, ?)")
+		args = append(args, cfg, text)
+	}
+	return sq.Expr("CROSS JOIN (SELECT "+strings.Join(parts, " || ")+" AS query) AS fts", args...)
+}
+
+// ftsSnippet — фрагменты заголовка и описания с подсвеченными совпадениями (<b>…</b>). Подсвечивает
+// тот же объединённый fts.query, что отбирает строки, а текст разбирается первой конфигурацией, в которой
+// он совпал с запросом: иначе слова, найденные по другому языку, остались бы без подсветки.
+func ftsSnippet(configs []string) sq.Sqlizer {
+	const (
+		doc      = "title || '. ' || description"
+		headline = "ts_headline(?::regconfig, " + doc + ", fts.query, " +
+			"'StartSel=<b>, StopSel=</b>, MaxWords=35, MinWords=15, MaxFragments=2')"
+	)
+
+	last := configs[len(configs)-1]
+	if len(configs) == 1 {
+		return sq.Expr(headline+" AS snippet", last)
+	}
+
+	var (
+		b    strings.Builder
+		args []interface{}
+	)
+	b.WriteString("CASE")
+	for _, cfg := range configs[:len(configs)-1] {
+		b.WriteString(" WHEN to_tsvector(?::regconfig, " + doc + ") @@ fts.query THEN " + headline)
+		args = append(args, cfg, cfg)
+	}
+	b.WriteString(" ELSE " + headline + " END AS snippet")
+	args = append(args, last)
+
+	return sq.Expr(b.String(), args...)
+}
+
+// searchConfigs — конфигурации запроса: явно заданные или все.
+func searchConfigs(configs []string) []string {
+	if len(configs) == 0 {
+		return SearchConfigs
+	}
+	return configs
+}
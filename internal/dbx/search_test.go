@@ -0,0 +1,59 @@
+package dbx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Сниппет подсвечивает тот же объединённый запрос, что отбирает строки, и разбирает текст
+// конфигурацией, в которой он совпал, а не всегда первой из списка.
+func TestSearchSnippetFollowsMatchedConfig(t *testing.T) {
+	const text = "ремонт дороги"
+
+	tests := []struct {
+		name      string
+		configs   []string
+		headlines int
+		args      []interface{}
+	}{
+		{
+			name:      "all languages",
+			headlines: 3,
+			args: []interface{}{
+				// snippet: WHEN russian THEN russian, WHEN ukrainian THEN ukrainian, ELSE english
+				SearchRussian, SearchRussian, SearchUkrainian, SearchUkrainian, SearchEnglish,
+				// fts.query
+				SearchRussian, text, SearchUkrainian, text, SearchEnglish, text,
+			},
+		},
+		{
+			name:      "one language",
+			configs:   []string{SearchEnglish},
+			headlines: 1,
+			args:      []interface{}{SearchEnglish, SearchEnglish, text},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args, err := newTable[Petition](nil, petitionsTable).search(text, tt.configs).selector.ToSql()
+			if err != nil {
+				t.Fatalf("ToSql: %v", err)
+			}
+
+			if n := strings.Count(query, "ts_headline("); n != tt.headlines {
+				t.Fatalf("%d ts_headline calls, want %d: %s", n, tt.headlines, query)
+			}
+			if n := strings.Count(query, "websearch_to_tsquery("); n != len(searchConfigs(tt.configs)) {
+				t.Fatalf("query is parsed %d times, want once per config in fts: %s", n, query)
+			}
+			if strings.Contains(query, "CASE") != (tt.headlines > 1) {
+				t.Fatalf("unexpected snippet expression: %s", query)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Fatalf("args = %v\nwant   %v", args, tt.args)
+			}
+		})
+	}
+}
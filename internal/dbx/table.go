@@ -0,0 +1,300 @@
+package dbx
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// table is the common part of every *Q: the query builders of one table and reading rows into T.
+//
+// The select list and the scan destinations both come from the db tags of T, so they cannot
+// drift apart. A tag may carry options: `db:"lat" expr:"ST_Y(location)"` selects an expression
+// under the column name, `db:"rank,optional"` is left out of the default select list and is only
+// filled when a query adds the column itself (see Search).
+//
+// Concrete query types embed table and wrap the builder helpers in one-line methods,
+// so that filters keep returning the concrete type.
+type table[T any] struct {
+	db       *sql.DB
+	name     string
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+
+	sort sortKey // активная сортировка, по ней строится курсор
+}
+
+func newTable[T any](db *sql.DB, name string) table[T] {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return table[T]{
+		db:       db,
+		name:     name,
+		selector: builder.Select(columnsOf[T]().selectCols...).From(name),
+		inserter: builder.Insert(name),
+		updater:  builder.Update(name),
+		deleter:  builder.Delete(name),
+		counter:  builder.Select("COUNT(*) AS count").From(name),
+	}
+}
+
+// ---- Выполнение запросов
+
+// querier — то, что умеют и *sql.DB, и *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// conn — транзакция из контекста (см. Transaction), если она есть, иначе пул.
+func conn(ctx context.Context, db *sql.DB) querier {
+	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// exec builds and runs a statement; what names it in the error, e.g. "inserter".
+func exec(ctx context.Context, db *sql.DB, what, table string, stmt sq.Sqlizer) error {
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("building %s query for table %s: %w", what, table, err)
+	}
+	_, err = conn(ctx, db).ExecContext(ctx, query, args...)
+	return err
+}
+
+// queryRows runs a select and scans every row into R by column name.
+func queryRows[R any](ctx context.Context, db *sql.DB, what, table string, stmt sq.Sqlizer) ([]R, error) {
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building %s query for table %s: %w", what, table, err)
+	}
+
+	rows, err := conn(ctx, db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	cols := columnsOf[R]()
+
+	var out []R
+	for rows.Next() {
+		var r R
+		dest, err := cols.dest(reflect.ValueOf(&r).Elem(), names)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+func (t table[T]) Get(ctx context.Context) (T, error) {
+	rows, err := queryRows[T](ctx, t.db, "selector", t.name, t.selector.Limit(1))
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if len(rows) == 0 {
+		var zero T
+		return zero, sql.ErrNoRows
+	}
+	return rows[0], nil
+}
+
+func (t table[T]) Select(ctx context.Context) ([]T, error) {
+	return queryRows[T](ctx, t.db, "selector", t.name, t.selector)
+}
+
+func (t table[T]) Count(ctx context.Context) (uint64, error) {
+	query, args, err := t.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for table %s: %w", t.name, err)
+	}
+
+	var count uint64
+	err = conn(ctx, t.db).QueryRowContext(ctx, query, args...).Scan(&count)
+	return count, err
+}
+
+func (t table[T]) Delete(ctx context.Context) error {
+	return exec(ctx, t.db, "deleter", t.name, t.deleter)
+}
+
+func (t table[T]) insert(ctx context.Context, values map[string]interface{}) error {
+	return exec(ctx, t.db, "inserter", t.name, t.inserter.SetMap(values))
+}
+
+// update — пустой набор изменений ничего не делает.
+func (t table[T]) update(ctx context.Context, values map[string]interface{}) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return exec(ctx, t.db, "updater", t.name, t.updater.SetMap(values))
+}
+
+// ---- Фильтры
+
+// where — условие для всех запросов: выборки, счётчика, обновления и удаления.
+func (t table[T]) where(pred interface{}, args ...interface{}) table[T] {
+	t.selector = t.selector.Where(pred, args...)
+	t.counter = t.counter.Where(pred, args...)
+	t.updater = t.updater.Where(pred, args...)
+	t.deleter = t.deleter.Where(pred, args...)
+	return t
+}
+
+// whereRead — условие только для выборки и счётчика (поиск, геофильтры).
+func (t table[T]) whereRead(pred interface{}, args ...interface{}) table[T] {
+	t.selector = t.selector.Where(pred, args...)
+	t.counter = t.counter.Where(pred, args...)
+	return t
+}
+
+// forUpdate блокирует выбранные строки до конца транзакции (имеет смысл только внутри Transaction).
+func (t table[T]) forUpdate() table[T] {
+	t.selector = t.selector.Suffix("FOR UPDATE")
+	return t
+}
+
+func (t table[T]) titleLike(s string) table[T] {
+	return t.whereRead("title ILIKE ?", fmt.Sprintf("%%%s%%", s))
+}
+
+// search — полнотекстовый поиск по search_vector; без configs запрос разбирается всеми SearchConfigs.
+// Добавляет в выборку rank и snippet (поля с тегом optional).
+func (t table[T]) search(text string, configs []string) table[T] {
+	configs = searchConfigs(configs)
+	join := ftsJoin(text, configs)
+	t.selector = t.selector.JoinClause(join).Where(ftsMatch).
+		Column(ftsRank + " AS rank").
+		Column(ftsSnippet(configs))
+	t.counter = t.counter.JoinClause(join).Where(ftsMatch)
+	return t
+}
+
+// Геофильтры (PostGIS)
+
+func (t table[T]) bbox(minLng, minLat, maxLng, maxLat float64) table[T] {
+	env := sq.Expr("ST_MakeEnvelope(?, ?, ?, ?, 4326)", minLng, minLat, maxLng, maxLat)
+	return t.whereRead(sq.Expr("location IS NOT NULL AND ST_Intersects(location, ?)", env))
+}
+
+func (t table[T]) withinRadius(lng, lat, radiusMeters float64) table[T] {
+	pt := sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", lng, lat)
+	return t.whereRead(sq.Expr("location IS NOT NULL AND ST_DWithin(location::geography, (? )::geography, ?)", pt, radiusMeters))
+}
+
+// geoPoint — значение колонки location; nil -> NULL.
+func geoPoint(p *GeoPoint) interface{} {
+	if p == nil {
+		return nil
+	}
+	return sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)", p.Lng, p.Lat)
+}
+
+// ---- Сортировка и пагинация
+
+func (t table[T]) orderBy(s sortKey) table[T] {
+	t.sort = s
+	t.selector = t.selector.OrderBy(s.orderBy()...)
+	return t
+}
+
+// page limits the selection to limit rows after the cursor (nil for the first page).
+// Without an explicit order rows go by created_at ascending.
+func (t table[T]) page(limit uint64, after *Cursor) (table[T], error) {
+	if t.sort == (sortKey{}) {
+		t = t.orderBy(sortKey{Column: "created_at"})
+	}
+
+	selector, err := keysetPage(t.selector, t.sort, limit, after)
+	if err != nil {
+		return t, err
+	}
+	t.selector = selector
+	return t, nil
+}
+
+// Cursor returns the position of the row in the active sort order, to be passed to Page for the next page.
+func (t table[T]) Cursor(r T) Cursor {
+	v := reflect.ValueOf(r)
+	cols := columnsOf[T]()
+	idx, ok := cols.fields[t.sort.field()]
+	if !ok {
+		idx = cols.fields["created_at"]
+	}
+	id, _ := v.FieldByIndex(cols.fields["id"]).Interface().(uuid.UUID)
+	return newCursor(t.sort, v.FieldByIndex(idx).Interface(), id)
+}
+
+// ---- Соответствие колонок и полей
+
+type columns struct {
+	selectCols []string
+	fields     map[string][]int // имя колонки -> индекс поля для FieldByIndex
+}
+
+var columnsCache sync.Map // reflect.Type -> *columns
+
+// columnsOf разбирает теги db структуры R один раз на тип.
+func columnsOf[R any]() *columns {
+	typ := reflect.TypeOf((*R)(nil)).Elem()
+	if c, ok := columnsCache.Load(typ); ok {
+		return c.(*columns)
+	}
+
+	c := &columns{fields: make(map[string][]int)}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag, ok := f.Tag.Lookup("db")
+		if !ok || tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		c.fields[name] = f.Index
+		if opts == "optional" {
+			continue
+		}
+		if expr, ok := f.Tag.Lookup("expr"); ok {
+			c.selectCols = append(c.selectCols, expr+" AS "+name)
+		} else {
+			c.selectCols = append(c.selectCols, name)
+		}
+	}
+
+	actual, _ := columnsCache.LoadOrStore(typ, c)
+	return actual.(*columns)
+}
+
+// dest — указатели на поля v в порядке колонок результата; колонка без поля — ошибка, а не тихий пропуск.
+func (c *columns) dest(v reflect.Value, names []string) ([]interface{}, error) {
+	dest := make([]interface{}, len(names))
+	for i, name := range names {
+		idx, ok := c.fields[name]
+		if !ok {
+			return nil, fmt.Errorf("column %s has no field in %s", name, v.Type())
+		}
+		dest[i] = v.FieldByIndex(idx).Addr().Interface()
+	}
+	return dest, nil
+}
@@ -0,0 +1,78 @@
+package dbx
+
+import (
+	"reflect"
+	"testing"
+)
+
+type taggedRow struct {
+	ID       string  `db:"id"`
+	Lat      float64 `db:"lat" expr:"ST_Y(location)"`
+	Title    string  `db:"title"`
+	Rank     float64 `db:"rank,optional"`
+	Internal string  `db:"-"`
+	Note     string
+	Lng      float64 `db:"lng" expr:"ST_X(location)"`
+}
+
+func TestColumnsOf(t *testing.T) {
+	cols := columnsOf[taggedRow]()
+
+	// порядок полей структуры; optional, "-" и поля без тега в выборку не попадают
+	wantSelect := []string{"id", "ST_Y(location) AS lat", "title", "ST_X(location) AS lng"}
+	if !reflect.DeepEqual(cols.selectCols, wantSelect) {
+		t.Fatalf("select columns = %q, want %q", cols.selectCols, wantSelect)
+	}
+
+	// сканировать можно и в optional-поле, когда запрос добавил колонку сам
+	wantFields := map[string][]int{"id": {0}, "lat": {1}, "title": {2}, "rank": {3}, "lng": {6}}
+	if !reflect.DeepEqual(cols.fields, wantFields) {
+		t.Fatalf("fields = %v, want %v", cols.fields, wantFields)
+	}
+
+	if columnsOf[taggedRow]() != cols {
+		t.Fatal("columns are parsed again instead of taken from the cache")
+	}
+
+	query, _, err := newTable[taggedRow](nil, "tagged").selector.ToSql()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SELECT id, ST_Y(location) AS lat, title, ST_X(location) AS lng FROM tagged"; query != want {
+		t.Fatalf("query = %q, want %q", query, want)
+	}
+}
+
+func TestColumnsDest(t *testing.T) {
+	cols := columnsOf[taggedRow]()
+	var row taggedRow
+	v := reflect.ValueOf(&row).Elem()
+
+	// порядок колонок результата, а не полей: так приходят строки с join'ами и добавленными колонками
+	dest, err := cols.dest(v, []string{"rank", "lng", "id", "title", "lat"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []interface{}{&row.Rank, &row.Lng, &row.ID, &row.Title, &row.Lat}
+	if len(dest) != len(want) {
+		t.Fatalf("got %d destinations, want %d", len(dest), len(want))
+	}
+	for i := range want {
+		if dest[i] != want[i] {
+			t.Fatalf("destination %d points to the wrong field", i)
+		}
+	}
+
+	// запись через указатели попадает в нужные поля
+	*dest[0].(*float64) = 0.5
+	*dest[2].(*string) = "id-1"
+	if row.Rank != 0.5 || row.ID != "id-1" {
+		t.Fatalf("row = %+v", row)
+	}
+
+	for _, name := range []string{"location", "Note", "-"} {
+		if _, err = cols.dest(v, []string{"id", name}); err == nil {
+			t.Fatalf("column %q without a field was accepted", name)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package dbx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	txMaxAttempts = 3
+	txRetryDelay  = 20 * time.Millisecond
+
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgUniqueViolation      = "23505"
+)
+
+type txDepthKeyType struct{}
+
+var txDepthKey = txDepthKeyType{}
+
+// Transaction runs fn in a transaction that every query type picks up from ctx via TxKey.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+//
+// Nested calls reuse the outer transaction and wrap fn in a savepoint, so an inner failure rolls back
+// only the inner work. The outermost call retries fn on serialization failures and deadlocks,
+// so fn must be safe to run more than once.
+func Transaction(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(TxKey).(*sql.Tx); ok {
+		return savepoint(ctx, tx, fn)
+	}
+
+	var err error
+	for attempt := 1; attempt <= txMaxAttempts; attempt++ {
+		err = transaction(ctx, db, fn)
+		if err == nil || !retryable(err) || attempt == txMaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * txRetryDelay):
+		}
+	}
+	return err
+}
+
+func transaction(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) (err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	txCtx := context.WithValue(context.WithValue(ctx, TxKey, tx), txDepthKey, 0)
+	if err = fn(txCtx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("rolling back transaction: %w", rbErr))
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func savepoint(ctx context.Context, tx *sql.Tx, fn func(ctx context.Context) error) (err error) {
+	depth, _ := ctx.Value(txDepthKey).(int)
+	depth++
+	name := fmt.Sprintf("sp_%d", depth)
+
+	if _, err = tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("creating savepoint %s: %w", name, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_, _ = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(p)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, txDepthKey, depth)); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("rolling back to savepoint %s: %w", name, rbErr))
+		}
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("releasing savepoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// retryable — ошибки, после которых транзакцию имеет смысл повторить целиком.
+func retryable(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == pgSerializationFailure || pqErr.Code == pgDeadlockDetected
+}
+
+// IsUniqueViolation reports whether err is a violation of the named unique constraint. Entities use it
+// to tell a lost race between two identical requests from other conflicts.
+func IsUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation && pqErr.Constraint == constraint
+}
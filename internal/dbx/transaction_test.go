@@ -0,0 +1,291 @@
+package dbx
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+// recorder — драйвер без базы: пишет BEGIN/COMMIT/ROLLBACK и выполненные запросы в журнал
+// и по желанию теста отвечает ошибкой на COMMIT.
+type recorder struct {
+	mu        sync.Mutex
+	log       []string
+	commitErr []error // ошибки очередных COMMIT; nil — успех
+}
+
+func (r *recorder) write(entry string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.log = append(r.log, entry)
+}
+
+func (r *recorder) commit() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.log = append(r.log, "COMMIT")
+	if len(r.commitErr) == 0 {
+		return nil
+	}
+	err := r.commitErr[0]
+	r.commitErr = r.commitErr[1:]
+	return err
+}
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return recorderConn{r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return nil }
+
+type recorderConn struct{ r *recorder }
+
+func (c recorderConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c recorderConn) Close() error                        { return nil }
+func (c recorderConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c recorderConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.r.write("BEGIN")
+	return recorderTx(c), nil
+}
+
+func (c recorderConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.r.write(query)
+	return driver.RowsAffected(1), nil
+}
+
+type recorderTx struct{ r *recorder }
+
+func (t recorderTx) Commit() error {
+	return t.r.commit()
+}
+
+func (t recorderTx) Rollback() error {
+	t.r.write("ROLLBACK")
+	return nil
+}
+
+func newRecorder(t *testing.T) (*recorder, *sql.DB) {
+	r := &recorder{}
+	db := sql.OpenDB(r)
+	t.Cleanup(func() { _ = db.Close() })
+	return r, db
+}
+
+// execInTx выполняет запрос в транзакции из контекста, как это делают типы запросов dbx.
+func execInTx(t *testing.T, ctx context.Context, query string) {
+	t.Helper()
+	tx, ok := ctx.Value(TxKey).(*sql.Tx)
+	if !ok {
+		t.Fatal("no transaction in context")
+	}
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+}
+
+func checkLog(t *testing.T, r *recorder, want ...string) {
+	t.Helper()
+	if !reflect.DeepEqual(r.log, want) {
+		t.Fatalf("log = %q\nwant  %q", r.log, want)
+	}
+}
+
+var errSerialization = &pq.Error{Code: pgSerializationFailure}
+
+func TestTransactionCommits(t *testing.T) {
+	r, db := newRecorder(t)
+
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		execInTx(t, ctx, "UPDATE polls")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction: %v", err)
+	}
+	checkLog(t, r, "BEGIN", "UPDATE polls", "COMMIT")
+}
+
+// Повтор начинает новую транзакцию и запускает замыкание заново, с новым контекстом.
+func TestTransactionRetriesFromScratch(t *testing.T) {
+	r, db := newRecorder(t)
+
+	var (
+		attempts int
+		txs      []*sql.Tx
+	)
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		attempts++
+		txs = append(txs, ctx.Value(TxKey).(*sql.Tx))
+		execInTx(t, ctx, "UPDATE polls")
+		if attempts == 1 {
+			return errSerialization
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction: %v", err)
+	}
+	if attempts != 2 || txs[0] == txs[1] {
+		t.Fatalf("attempts = %d, want 2 in separate transactions", attempts)
+	}
+	checkLog(t, r,
+		"BEGIN", "UPDATE polls", "ROLLBACK",
+		"BEGIN", "UPDATE polls", "COMMIT",
+	)
+}
+
+// Postgres может сообщить о конфликте сериализации только на COMMIT — это тоже повод повторить.
+func TestTransactionRetriesFailedCommit(t *testing.T) {
+	r, db := newRecorder(t)
+	r.commitErr = []error{&pq.Error{Code: pgDeadlockDetected}}
+
+	attempts := 0
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		attempts++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+	checkLog(t, r, "BEGIN", "COMMIT", "BEGIN", "COMMIT")
+}
+
+func TestTransactionGivesUpAfterMaxAttempts(t *testing.T) {
+	_, db := newRecorder(t)
+
+	attempts := 0
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		attempts++
+		return errSerialization
+	})
+	if !errors.Is(err, errSerialization) {
+		t.Fatalf("err = %v, want the serialization failure", err)
+	}
+	if attempts != txMaxAttempts {
+		t.Fatalf("attempts = %d, want %d", attempts, txMaxAttempts)
+	}
+}
+
+func TestTransactionDoesNotRetryOtherErrors(t *testing.T) {
+	tests := map[string]error{
+		"domain error":     errors.New("poll is closed"),
+		"unique violation": &pq.Error{Code: "23505"},
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, db := newRecorder(t)
+
+			attempts := 0
+			err := Transaction(context.Background(), db, func(ctx context.Context) error {
+				attempts++
+				return want
+			})
+			if !errors.Is(err, want) {
+				t.Fatalf("err = %v, want %v", err, want)
+			}
+			if attempts != 1 {
+				t.Fatalf("attempts = %d, want 1", attempts)
+			}
+			checkLog(t, r, "BEGIN", "ROLLBACK")
+		})
+	}
+}
+
+func TestNestedTransactionUsesSavepoints(t *testing.T) {
+	r, db := newRecorder(t)
+
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		outer := ctx.Value(TxKey)
+		execInTx(t, ctx, "UPDATE polls")
+		return Transaction(ctx, db, func(ctx context.Context) error {
+			if ctx.Value(TxKey) != outer {
+				t.Fatal("nested call opened another transaction")
+			}
+			execInTx(t, ctx, "INSERT INTO poll_votes")
+			return Transaction(ctx, db, func(ctx context.Context) error {
+				execInTx(t, ctx, "INSERT INTO poll_participants")
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		t.Fatalf("Transaction: %v", err)
+	}
+	checkLog(t, r,
+		"BEGIN",
+		"UPDATE polls",
+		"SAVEPOINT sp_1",
+		"INSERT INTO poll_votes",
+		"SAVEPOINT sp_2",
+		"INSERT INTO poll_participants",
+		"RELEASE SAVEPOINT sp_2",
+		"RELEASE SAVEPOINT sp_1",
+		"COMMIT",
+	)
+}
+
+// Ошибка вложенного вызова откатывает только его работу; повторяет транзакцию лишь внешний вызов.
+func TestNestedTransactionRollsBackToSavepoint(t *testing.T) {
+	r, db := newRecorder(t)
+
+	inner := 0
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		execInTx(t, ctx, "UPDATE polls")
+		err := Transaction(ctx, db, func(ctx context.Context) error {
+			inner++
+			execInTx(t, ctx, "INSERT INTO poll_votes")
+			return errSerialization
+		})
+		if !errors.Is(err, errSerialization) {
+			t.Fatalf("nested err = %v, want the serialization failure", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction: %v", err)
+	}
+	if inner != 1 {
+		t.Fatalf("nested closure ran %d times, want 1", inner)
+	}
+	checkLog(t, r,
+		"BEGIN",
+		"UPDATE polls",
+		"SAVEPOINT sp_1",
+		"INSERT INTO poll_votes",
+		"ROLLBACK TO SAVEPOINT sp_1",
+		"COMMIT",
+	)
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"named constraint": {err: &pq.Error{Code: pgUniqueViolation, Constraint: PetitionSignatureUserKey}, want: true},
+		"wrapped":          {err: fmt.Errorf("signing: %w", &pq.Error{Code: pgUniqueViolation, Constraint: PetitionSignatureUserKey}), want: true},
+		"other constraint": {err: &pq.Error{Code: pgUniqueViolation, Constraint: "petition_signatures_pkey"}},
+		"other error code": {err: &pq.Error{Code: "23503", Constraint: PetitionSignatureUserKey}},
+		"not postgres":     {err: errors.New("petition_signatures_petition_id_user_id_key")},
+		"no error":         {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUniqueViolation(tt.err, PetitionSignatureUserKey); got != tt.want {
+				t.Fatalf("IsUniqueViolation = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -43,3 +43,12 @@ func MigrateDown(cfg config.Config) error {
 	logrus.WithField("applied", applied).Info("migrations applied")
 	return nil
 }
+
+// PendingMigrations returns how many embedded migrations are not applied to the database yet.
+func PendingMigrations(db *sql.DB) (int, error) {
+	planned, _, err := migrate.PlanMigration(db, "postgres", migrations, migrate.Up, 0)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to plan migrations")
+	}
+	return len(planned), nil
+}
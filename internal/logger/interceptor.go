@@ -0,0 +1,169 @@
+package logger
+
+import (
+	"context"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// validRequestID — допустимый входящий x-request-id: UUID, trace ID прокси и подобные токены без пробелов
+// и управляющих символов.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// quietMethods — служебные вызовы оркестратора, логируются только на debug.
+var quietMethods = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// UnaryLogInterceptor has to be the outermost interceptor: it assigns the request ID, attaches the logger,
+// logs the outcome of the call and turns panics into Internal errors.
+func UnaryLogInterceptor(log Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		// Вместо context.Background() используем входящий ctx,
+		// чтобы не потерять таймауты и другую информацию.
+		ctx, call, entry := startCall(ctx, log, info.FullMethod)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(interceptors.RequestIDHeader, call.RequestID))
+
+		start := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(entry, r)
+			}
+			finishCall(entry, call, info.FullMethod, start, err)
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamLogInterceptor is the streaming counterpart of UnaryLogInterceptor.
+func StreamLogInterceptor(log Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		ctx, call, entry := startCall(ss.Context(), log, info.FullMethod)
+		_ = ss.SetHeader(metadata.Pairs(interceptors.RequestIDHeader, call.RequestID))
+
+		start := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(entry, r)
+			}
+			finishCall(entry, call, info.FullMethod, start, err)
+		}()
+
+		return handler(srv, &loggedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// startCall берёт x-request-id из метаданных (или генерирует новый, если заголовка нет) и кладёт в контекст логгер с ним.
+func startCall(ctx context.Context, log Logger, method string) (context.Context, *interceptors.CallInfo, Logger) {
+	call := &interceptors.CallInfo{RequestID: requestID(ctx)}
+
+	entry := &logger{Entry: log.WithFields(logrus.Fields{
+		"request_id": call.RequestID,
+		"method":     method,
+	})}
+
+	ctx = context.WithValue(ctx, interceptors.CallCtxKey, call)
+	ctx = context.WithValue(ctx, interceptors.LogCtxKey, Logger(entry))
+	return ctx, call, entry
+}
+
+func finishCall(entry Logger, call *interceptors.CallInfo, method string, start time.Time, err error) {
+	code := status.Code(err)
+	fields := logrus.Fields{
+		"code":    code.String(),
+		"latency": time.Since(start).String(),
+	}
+	if call.UserID != uuid.Nil {
+		fields["user_id"] = call.UserID
+	}
+	e := entry.WithFields(fields)
+	if err != nil {
+		// WithError логгера раскрывает причину доменной ошибки
+		e = entry.WithError(err).WithFields(fields)
+	}
+
+	switch {
+	case isQuiet(method):
+		e.Debug("call finished")
+	case serverFault(code):
+		e.Error("call failed")
+	case code != codes.OK:
+		e.Warn("call rejected")
+	default:
+		e.Info("call finished")
+	}
+}
+
+// recovered логирует панику со стеком; клиенту уходит только Internal.
+func recovered(entry Logger, r interface{}) error {
+	entry.WithFields(logrus.Fields{
+		"panic": r,
+		"stack": string(debug.Stack()),
+	}).Error("panic in handler")
+	return ape.ErrInternal
+}
+
+// requestID пробрасывает ID запроса клиента или прокси, чтобы он совпадал в их логах. ID попадает в логи
+// и в заголовок ответа, поэтому принимается только validRequestID; иначе генерируется новый.
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(interceptors.RequestIDHeader) {
+		if v = strings.TrimSpace(v); v != "" {
+			if validRequestID.MatchString(v) {
+				return v
+			}
+			break
+		}
+	}
+	return uuid.NewString()
+}
+
+func serverFault(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		return true
+	}
+	return false
+}
+
+func isQuiet(method string) bool {
+	for _, prefix := range quietMethods {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// loggedStream подменяет контекст стрима на контекст с логгером.
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
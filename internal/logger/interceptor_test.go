@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestID(t *testing.T) {
+	longest := strings.Repeat("a", 128)
+
+	tests := []struct {
+		name   string
+		header []string
+		want   string // пусто — ожидается сгенерированный UUID
+	}{
+		{name: "uuid", header: []string{"7f1c2a4e-1d55-4b6e-9a36-54c1d1c6b2a0"}, want: "7f1c2a4e-1d55-4b6e-9a36-54c1d1c6b2a0"},
+		{name: "dots and underscores", header: []string{"web_1.req-42"}, want: "web_1.req-42"},
+		{name: "surrounding spaces", header: []string{"  req-42 "}, want: "req-42"},
+		{name: "longest accepted", header: []string{longest}, want: longest},
+		{name: "first non-empty value", header: []string{" ", "req-42"}, want: "req-42"},
+		{name: "missing header"},
+		{name: "empty header", header: []string{""}},
+		{name: "too long", header: []string{longest + "a"}},
+		{name: "inner space", header: []string{"req 42"}},
+		{name: "proxy format with equals sign", header: []string{"Root=1-67891233-abcdef012345678912345678"}},
+		{name: "log injection", header: []string{"req-42\nlevel=error msg=forged"}},
+		{name: "control character", header: []string{"req-42\x1b[31m"}},
+		{name: "non-ascii", header: []string{"запрос-42"}},
+		{name: "invalid first value is not skipped", header: []string{"req 42", "req-43"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			for _, v := range tt.header {
+				md.Append(interceptors.RequestIDHeader, v)
+			}
+			got := requestID(metadata.NewIncomingContext(context.Background(), md))
+
+			if tt.want != "" {
+				if got != tt.want {
+					t.Fatalf("request id = %q, want %q", got, tt.want)
+				}
+				return
+			}
+			if _, err := uuid.Parse(got); err != nil {
+				t.Fatalf("request id = %q, want a generated uuid", got)
+			}
+		})
+	}
+}
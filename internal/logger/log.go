@@ -4,34 +4,12 @@ import (
 	"context"
 	"errors"
 
-	//"github.com/chains-lab/voting-svc/internal/ape"
-	//"github.com/chains-lab/voting-svc/internal/api/interceptors"
-	"github.com/google/uuid"
+	"github.com/chains-lab/voting-svc/internal/ape"
+	"github.com/chains-lab/voting-svc/internal/api/interceptors"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 )
 
-func UnaryLogInterceptor(log Logger) grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		// Вместо context.Background() используем входящий ctx,
-		// чтобы не потерять таймауты и другую информацию.
-		ctxWithLog := context.WithValue(
-			ctx,
-			interceptors.LogCtxKey,
-			log, // ваш интерфейс Logger
-		)
-
-		// Далее передаём новый контекст в реальный хэндлер
-		return handler(ctxWithLog, req)
-	}
-}
-
-func Log(ctx context.Context, requestID uuid.UUID) Logger {
+func Log(ctx context.Context, requestID string) Logger {
 	entry, ok := ctx.Value(interceptors.LogCtxKey).(Logger)
 	if !ok {
 		logrus.Info("no logger in context")
@@ -41,6 +19,14 @@ func Log(ctx context.Context, requestID uuid.UUID) Logger {
 	return &logger{Entry: entry.WithField("request_id", requestID)}
 }
 
+// FromContext returns the logger the logging interceptor attached to the call, already carrying its request ID.
+func FromContext(ctx context.Context) Logger {
+	if entry, ok := ctx.Value(interceptors.LogCtxKey).(Logger); ok {
+		return entry
+	}
+	return NewWithBase(logrus.StandardLogger())
+}
+
 // Logger — это ваш интерфейс: все методы FieldLogger + специальный WithError.
 type Logger interface {
 	WithError(err error) *logrus.Entry
@@ -56,7 +42,8 @@ type logger struct {
 // WithError — ваш особый метод.
 func (l *logger) WithError(err error) *logrus.Entry {
 	var ae *ape.Error
-	if errors.As(err, &ae) {
+	if errors.As(err, &ae) && ae.Unwrap() != nil {
+		// клиенту ушла доменная ошибка, а в лог пишем исходную
 		return l.Entry.WithError(ae.Unwrap())
 	}
 	// для “обычных” ошибок просто стандартный путь
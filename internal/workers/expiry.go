@@ -0,0 +1,39 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/app"
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultExpiryInterval = time.Minute
+
+// RunExpiry periodically closes petitions, polls and proposals whose end_date has passed.
+// Every tick is a set of conditional UPDATEs, so several replicas may run it at once.
+func RunExpiry(ctx context.Context, cfg config.Config, log *logrus.Logger, app *app.App) error {
+	interval := cfg.Workers.Expiry.Interval
+	if interval <= 0 {
+		interval = defaultExpiryInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Infof("expiry worker started, interval %s", interval)
+	for {
+		if err := app.CloseExpired(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			// ошибка одного прогона не должна останавливать сервис — повторим на следующем тике
+			log.WithError(err).Error("failed to close expired items")
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("expiry worker stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/voting-svc/internal/app"
+	"github.com/chains-lab/voting-svc/internal/config"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultLiveInterval = time.Second
+
+// RunLive feeds the live tally streams. The interval coalesces bursts of votes into one update per watcher.
+func RunLive(ctx context.Context, cfg config.Config, log *logrus.Logger, app *app.App) error {
+	interval := cfg.Workers.Live.Interval
+	if interval <= 0 {
+		interval = defaultLiveInterval
+	}
+
+	log.Infof("live tallies started, interval %s", interval)
+	err := app.RunLive(ctx, interval, log)
+	log.Info("live tallies stopped")
+	return err
+}
@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: voting/v1/admin.proto
+
+package svc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPendingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Limit  uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page; empty for the first page.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingRequest) Reset() {
+	*x = ListPendingRequest{}
+	mi := &file_voting_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingRequest) ProtoMessage() {}
+
+func (x *ListPendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPendingRequest) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *ListPendingRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPendingRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ModerateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required when declining.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateRequest) Reset() {
+	*x = ModerateRequest{}
+	mi := &file_voting_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateRequest) ProtoMessage() {}
+
+func (x *ModerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateRequest.ProtoReflect.Descriptor instead.
+func (*ModerateRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ModerateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EditContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditContentRequest) Reset() {
+	*x = EditContentRequest{}
+	mi := &file_voting_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditContentRequest) ProtoMessage() {}
+
+func (x *EditContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditContentRequest.ProtoReflect.Descriptor instead.
+func (*EditContentRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EditContentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditContentRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *EditContentRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *EditContentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteUserContributionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Petition, poll or proposal ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Author of the signature or vote to remove.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserContributionRequest) Reset() {
+	*x = DeleteUserContributionRequest{}
+	mi := &file_voting_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserContributionRequest) ProtoMessage() {}
+
+func (x *DeleteUserContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserContributionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserContributionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteUserContributionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteUserContributionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserContributionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListModerationHistoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntityId string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Limit    uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page; empty for the first page.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationHistoryRequest) Reset() {
+	*x = ListModerationHistoryRequest{}
+	mi := &file_voting_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationHistoryRequest) ProtoMessage() {}
+
+func (x *ListModerationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListModerationHistoryRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListModerationHistoryRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListModerationHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListModerationHistoryResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*ModerationEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Number of all matches, independent of paging.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Token of the next page; empty on the last one.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationHistoryResponse) Reset() {
+	*x = ListModerationHistoryResponse{}
+	mi := &file_voting_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationHistoryResponse) ProtoMessage() {}
+
+func (x *ListModerationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_voting_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListModerationHistoryResponse) GetEntries() []*ModerationEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListModerationHistoryResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListModerationHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_voting_v1_admin_proto protoreflect.FileDescriptor
+
+const file_voting_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x15voting/v1/admin.proto\x12\tvoting.v1\x1a\x18voting/v1/entities.proto\x1a\x14voting/v1/user.proto\"p\n" +
+	"\x12ListPendingRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06offset\"9\n" +
+	"\x0fModerateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x98\x01\n" +
+	"\x12EditContentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reasonB\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_description\"`\n" +
+	"\x1dDeleteUserContributionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"~\n" +
+	"\x1cListModerationHistoryRequest\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06offset\"\x93\x01\n" +
+	"\x1dListModerationHistoryResponse\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.voting.v1.ModerationEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken2\x8c\v\n" +
+	"\fAdminService\x12W\n" +
+	"\x14ListPendingPetitions\x12\x1d.voting.v1.ListPendingRequest\x1a .voting.v1.ListPetitionsResponse\x12B\n" +
+	"\x0fPublishPetition\x12\x1a.voting.v1.ModerateRequest\x1a\x13.voting.v1.Petition\x12B\n" +
+	"\x0fDeclinePetition\x12\x1a.voting.v1.ModerateRequest\x1a\x13.voting.v1.Petition\x12C\n" +
+	"\x10WithdrawPetition\x12\x1a.voting.v1.ModerateRequest\x1a\x13.voting.v1.Petition\x12B\n" +
+	"\fEditPetition\x12\x1d.voting.v1.EditContentRequest\x1a\x13.voting.v1.Petition\x12X\n" +
+	"\x17DeletePetitionSignature\x12(.voting.v1.DeleteUserContributionRequest\x1a\x13.voting.v1.Petition\x12O\n" +
+	"\x10ListPendingPolls\x12\x1d.voting.v1.ListPendingRequest\x1a\x1c.voting.v1.ListPollsResponse\x12:\n" +
+	"\vPublishPoll\x12\x1a.voting.v1.ModerateRequest\x1a\x0f.voting.v1.Poll\x12:\n" +
+	"\vDeclinePoll\x12\x1a.voting.v1.ModerateRequest\x1a\x0f.voting.v1.Poll\x12;\n" +
+	"\fWithdrawPoll\x12\x1a.voting.v1.ModerateRequest\x1a\x0f.voting.v1.Poll\x12:\n" +
+	"\bEditPoll\x12\x1d.voting.v1.EditContentRequest\x1a\x0f.voting.v1.Poll\x12K\n" +
+	"\x0eDeletePollVote\x12(.voting.v1.DeleteUserContributionRequest\x1a\x0f.voting.v1.Poll\x12W\n" +
+	"\x14ListPendingProposals\x12\x1d.voting.v1.ListPendingRequest\x1a .voting.v1.ListProposalsResponse\x12B\n" +
+	"\x0fPublishProposal\x12\x1a.voting.v1.ModerateRequest\x1a\x13.voting.v1.Proposal\x12B\n" +
+	"\x0fDeclineProposal\x12\x1a.voting.v1.ModerateRequest\x1a\x13.voting.v1.Proposal\x12C\n" +
+	"\x10WithdrawProposal\x12\x1a.voting.v1.ModerateRequest\x1a\x13.voting.v1.Proposal\x12B\n" +
+	"\fEditProposal\x12\x1d.voting.v1.EditContentRequest\x1a\x13.voting.v1.Proposal\x12S\n" +
+	"\x12DeleteProposalVote\x12(.voting.v1.DeleteUserContributionRequest\x1a\x13.voting.v1.Proposal\x12j\n" +
+	"\x15ListModerationHistory\x12'.voting.v1.ListModerationHistoryRequest\x1a(.voting.v1.ListModerationHistoryResponseB.Z,github.com/chains-lab/voting-svc/pkg/svc;svcb\x06proto3"
+
+var (
+	file_voting_v1_admin_proto_rawDescOnce sync.Once
+	file_voting_v1_admin_proto_rawDescData []byte
+)
+
+func file_voting_v1_admin_proto_rawDescGZIP() []byte {
+	file_voting_v1_admin_proto_rawDescOnce.Do(func() {
+		file_voting_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_voting_v1_admin_proto_rawDesc), len(file_voting_v1_admin_proto_rawDesc)))
+	})
+	return file_voting_v1_admin_proto_rawDescData
+}
+
+var file_voting_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_voting_v1_admin_proto_goTypes = []any{
+	(*ListPendingRequest)(nil),            // 0: voting.v1.ListPendingRequest
+	(*ModerateRequest)(nil),               // 1: voting.v1.ModerateRequest
+	(*EditContentRequest)(nil),            // 2: voting.v1.EditContentRequest
+	(*DeleteUserContributionRequest)(nil), // 3: voting.v1.DeleteUserContributionRequest
+	(*ListModerationHistoryRequest)(nil),  // 4: voting.v1.ListModerationHistoryRequest
+	(*ListModerationHistoryResponse)(nil), // 5: voting.v1.ListModerationHistoryResponse
+	(*ModerationEntry)(nil),               // 6: voting.v1.ModerationEntry
+	(*ListPetitionsResponse)(nil),         // 7: voting.v1.ListPetitionsResponse
+	(*Petition)(nil),                      // 8: voting.v1.Petition
+	(*ListPollsResponse)(nil),             // 9: voting.v1.ListPollsResponse
+	(*Poll)(nil),                          // 10: voting.v1.Poll
+	(*ListProposalsResponse)(nil),         // 11: voting.v1.ListProposalsResponse
+	(*Proposal)(nil),                      // 12: voting.v1.Proposal
+}
+var file_voting_v1_admin_proto_depIdxs = []int32{
+	6,  // 0: voting.v1.ListModerationHistoryResponse.entries:type_name -> voting.v1.ModerationEntry
+	0,  // 1: voting.v1.AdminService.ListPendingPetitions:input_type -> voting.v1.ListPendingRequest
+	1,  // 2: voting.v1.AdminService.PublishPetition:input_type -> voting.v1.ModerateRequest
+	1,  // 3: voting.v1.AdminService.DeclinePetition:input_type -> voting.v1.ModerateRequest
+	1,  // 4: voting.v1.AdminService.WithdrawPetition:input_type -> voting.v1.ModerateRequest
+	2,  // 5: voting.v1.AdminService.EditPetition:input_type -> voting.v1.EditContentRequest
+	3,  // 6: voting.v1.AdminService.DeletePetitionSignature:input_type -> voting.v1.DeleteUserContributionRequest
+	0,  // 7: voting.v1.AdminService.ListPendingPolls:input_type -> voting.v1.ListPendingRequest
+	1,  // 8: voting.v1.AdminService.PublishPoll:input_type -> voting.v1.ModerateRequest
+	1,  // 9: voting.v1.AdminService.DeclinePoll:input_type -> voting.v1.ModerateRequest
+	1,  // 10: voting.v1.AdminService.WithdrawPoll:input_type -> voting.v1.ModerateRequest
+	2,  // 11: voting.v1.AdminService.EditPoll:input_type -> voting.v1.EditContentRequest
+	3,  // 12: voting.v1.AdminService.DeletePollVote:input_type -> voting.v1.DeleteUserContributionRequest
+	0,  // 13: voting.v1.AdminService.ListPendingProposals:input_type -> voting.v1.ListPendingRequest
+	1,  // 14: voting.v1.AdminService.PublishProposal:input_type -> voting.v1.ModerateRequest
+	1,  // 15: voting.v1.AdminService.DeclineProposal:input_type -> voting.v1.ModerateRequest
+	1,  // 16: voting.v1.AdminService.WithdrawProposal:input_type -> voting.v1.ModerateRequest
+	2,  // 17: voting.v1.AdminService.EditProposal:input_type -> voting.v1.EditContentRequest
+	3,  // 18: voting.v1.AdminService.DeleteProposalVote:input_type -> voting.v1.DeleteUserContributionRequest
+	4,  // 19: voting.v1.AdminService.ListModerationHistory:input_type -> voting.v1.ListModerationHistoryRequest
+	7,  // 20: voting.v1.AdminService.ListPendingPetitions:output_type -> voting.v1.ListPetitionsResponse
+	8,  // 21: voting.v1.AdminService.PublishPetition:output_type -> voting.v1.Petition
+	8,  // 22: voting.v1.AdminService.DeclinePetition:output_type -> voting.v1.Petition
+	8,  // 23: voting.v1.AdminService.WithdrawPetition:output_type -> voting.v1.Petition
+	8,  // 24: voting.v1.AdminService.EditPetition:output_type -> voting.v1.Petition
+	8,  // 25: voting.v1.AdminService.DeletePetitionSignature:output_type -> voting.v1.Petition
+	9,  // 26: voting.v1.AdminService.ListPendingPolls:output_type -> voting.v1.ListPollsResponse
+	10, // 27: voting.v1.AdminService.PublishPoll:output_type -> voting.v1.Poll
+	10, // 28: voting.v1.AdminService.DeclinePoll:output_type -> voting.v1.Poll
+	10, // 29: voting.v1.AdminService.WithdrawPoll:output_type -> voting.v1.Poll
+	10, // 30: voting.v1.AdminService.EditPoll:output_type -> voting.v1.Poll
+	10, // 31: voting.v1.AdminService.DeletePollVote:output_type -> voting.v1.Poll
+	11, // 32: voting.v1.AdminService.ListPendingProposals:output_type -> voting.v1.ListProposalsResponse
+	12, // 33: voting.v1.AdminService.PublishProposal:output_type -> voting.v1.Proposal
+	12, // 34: voting.v1.AdminService.DeclineProposal:output_type -> voting.v1.Proposal
+	12, // 35: voting.v1.AdminService.WithdrawProposal:output_type -> voting.v1.Proposal
+	12, // 36: voting.v1.AdminService.EditProposal:output_type -> voting.v1.Proposal
+	12, // 37: voting.v1.AdminService.DeleteProposalVote:output_type -> voting.v1.Proposal
+	5,  // 38: voting.v1.AdminService.ListModerationHistory:output_type -> voting.v1.ListModerationHistoryResponse
+	20, // [20:39] is the sub-list for method output_type
+	1,  // [1:20] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_voting_v1_admin_proto_init() }
+func file_voting_v1_admin_proto_init() {
+	if File_voting_v1_admin_proto != nil {
+		return
+	}
+	file_voting_v1_entities_proto_init()
+	file_voting_v1_user_proto_init()
+	file_voting_v1_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_voting_v1_admin_proto_rawDesc), len(file_voting_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_voting_v1_admin_proto_goTypes,
+		DependencyIndexes: file_voting_v1_admin_proto_depIdxs,
+		MessageInfos:      file_voting_v1_admin_proto_msgTypes,
+	}.Build()
+	File_voting_v1_admin_proto = out.File
+	file_voting_v1_admin_proto_goTypes = nil
+	file_voting_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: voting/v1/admin.proto
+
+package svc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_ListPendingPetitions_FullMethodName    = "/voting.v1.AdminService/ListPendingPetitions"
+	AdminService_PublishPetition_FullMethodName         = "/voting.v1.AdminService/PublishPetition"
+	AdminService_DeclinePetition_FullMethodName         = "/voting.v1.AdminService/DeclinePetition"
+	AdminService_WithdrawPetition_FullMethodName        = "/voting.v1.AdminService/WithdrawPetition"
+	AdminService_EditPetition_FullMethodName            = "/voting.v1.AdminService/EditPetition"
+	AdminService_DeletePetitionSignature_FullMethodName = "/voting.v1.AdminService/DeletePetitionSignature"
+	AdminService_ListPendingPolls_FullMethodName        = "/voting.v1.AdminService/ListPendingPolls"
+	AdminService_PublishPoll_FullMethodName             = "/voting.v1.AdminService/PublishPoll"
+	AdminService_DeclinePoll_FullMethodName             = "/voting.v1.AdminService/DeclinePoll"
+	AdminService_WithdrawPoll_FullMethodName            = "/voting.v1.AdminService/WithdrawPoll"
+	AdminService_EditPoll_FullMethodName                = "/voting.v1.AdminService/EditPoll"
+	AdminService_DeletePollVote_FullMethodName          = "/voting.v1.AdminService/DeletePollVote"
+	AdminService_ListPendingProposals_FullMethodName    = "/voting.v1.AdminService/ListPendingProposals"
+	AdminService_PublishProposal_FullMethodName         = "/voting.v1.AdminService/PublishProposal"
+	AdminService_DeclineProposal_FullMethodName         = "/voting.v1.AdminService/DeclineProposal"
+	AdminService_WithdrawProposal_FullMethodName        = "/voting.v1.AdminService/WithdrawProposal"
+	AdminService_EditProposal_FullMethodName            = "/voting.v1.AdminService/EditProposal"
+	AdminService_DeleteProposalVote_FullMethodName      = "/voting.v1.AdminService/DeleteProposalVote"
+	AdminService_ListModerationHistory_FullMethodName   = "/voting.v1.AdminService/ListModerationHistory"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService holds the moderation RPCs. The caller must be a moderator of the city the content belongs to;
+// every action is recorded in the moderation log under the moderator ID from the access token.
+type AdminServiceClient interface {
+	// Processed petitions of the city, oldest first.
+	ListPendingPetitions(ctx context.Context, in *ListPendingRequest, opts ...grpc.CallOption) (*ListPetitionsResponse, error)
+	PublishPetition(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Petition, error)
+	DeclinePetition(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Petition, error)
+	WithdrawPetition(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Petition, error)
+	EditPetition(ctx context.Context, in *EditContentRequest, opts ...grpc.CallOption) (*Petition, error)
+	DeletePetitionSignature(ctx context.Context, in *DeleteUserContributionRequest, opts ...grpc.CallOption) (*Petition, error)
+	// Processed polls of the city, oldest first.
+	ListPendingPolls(ctx context.Context, in *ListPendingRequest, opts ...grpc.CallOption) (*ListPollsResponse, error)
+	PublishPoll(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Poll, error)
+	DeclinePoll(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Poll, error)
+	WithdrawPoll(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Poll, error)
+	EditPoll(ctx context.Context, in *EditContentRequest, opts ...grpc.CallOption) (*Poll, error)
+	// Not available for secret polls, whose ballots are not linked to voters.
+	DeletePollVote(ctx context.Context, in *DeleteUserContributionRequest, opts ...grpc.CallOption) (*Poll, error)
+	// Processed proposals of the city, oldest first.
+	ListPendingProposals(ctx context.Context, in *ListPendingRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error)
+	PublishProposal(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Proposal, error)
+	DeclineProposal(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Proposal, error)
+	WithdrawProposal(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Proposal, error)
+	EditProposal(ctx context.Context, in *EditContentRequest, opts ...grpc.CallOption) (*Proposal, error)
+	DeleteProposalVote(ctx context.Context, in *DeleteUserContributionRequest, opts ...grpc.CallOption) (*Proposal, error)
+	// Moderation actions on a petition, poll or proposal, newest first.
+	ListModerationHistory(ctx context.Context, in *ListModerationHistoryRequest, opts ...grpc.CallOption) (*ListModerationHistoryResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListPendingPetitions(ctx context.Context, in *ListPendingRequest, opts ...grpc.CallOption) (*ListPetitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPetitionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPendingPetitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PublishPetition(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, AdminService_PublishPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeclinePetition(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, AdminService_DeclinePetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WithdrawPetition(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, AdminService_WithdrawPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EditPetition(ctx context.Context, in *EditContentRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, AdminService_EditPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePetitionSignature(ctx context.Context, in *DeleteUserContributionRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, AdminService_DeletePetitionSignature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPendingPolls(ctx context.Context, in *ListPendingRequest, opts ...grpc.CallOption) (*ListPollsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPollsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPendingPolls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PublishPoll(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, AdminService_PublishPoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeclinePoll(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, AdminService_DeclinePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WithdrawPoll(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, AdminService_WithdrawPoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EditPoll(ctx context.Context, in *EditContentRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, AdminService_EditPoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePollVote(ctx context.Context, in *DeleteUserContributionRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, AdminService_DeletePollVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPendingProposals(ctx context.Context, in *ListPendingRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProposalsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPendingProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PublishProposal(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, AdminService_PublishProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeclineProposal(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, AdminService_DeclineProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WithdrawProposal(ctx context.Context, in *ModerateRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, AdminService_WithdrawProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EditProposal(ctx context.Context, in *EditContentRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, AdminService_EditProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteProposalVote(ctx context.Context, in *DeleteUserContributionRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, AdminService_DeleteProposalVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListModerationHistory(ctx context.Context, in *ListModerationHistoryRequest, opts ...grpc.CallOption) (*ListModerationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListModerationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService holds the moderation RPCs. The caller must be a moderator of the city the content belongs to;
+// every action is recorded in the moderation log under the moderator ID from the access token.
+type AdminServiceServer interface {
+	// Processed petitions of the city, oldest first.
+	ListPendingPetitions(context.Context, *ListPendingRequest) (*ListPetitionsResponse, error)
+	PublishPetition(context.Context, *ModerateRequest) (*Petition, error)
+	DeclinePetition(context.Context, *ModerateRequest) (*Petition, error)
+	WithdrawPetition(context.Context, *ModerateRequest) (*Petition, error)
+	EditPetition(context.Context, *EditContentRequest) (*Petition, error)
+	DeletePetitionSignature(context.Context, *DeleteUserContributionRequest) (*Petition, error)
+	// Processed polls of the city, oldest first.
+	ListPendingPolls(context.Context, *ListPendingRequest) (*ListPollsResponse, error)
+	PublishPoll(context.Context, *ModerateRequest) (*Poll, error)
+	DeclinePoll(context.Context, *ModerateRequest) (*Poll, error)
+	WithdrawPoll(context.Context, *ModerateRequest) (*Poll, error)
+	EditPoll(context.Context, *EditContentRequest) (*Poll, error)
+	// Not available for secret polls, whose ballots are not linked to voters.
+	DeletePollVote(context.Context, *DeleteUserContributionRequest) (*Poll, error)
+	// Processed proposals of the city, oldest first.
+	ListPendingProposals(context.Context, *ListPendingRequest) (*ListProposalsResponse, error)
+	PublishProposal(context.Context, *ModerateRequest) (*Proposal, error)
+	DeclineProposal(context.Context, *ModerateRequest) (*Proposal, error)
+	WithdrawProposal(context.Context, *ModerateRequest) (*Proposal, error)
+	EditProposal(context.Context, *EditContentRequest) (*Proposal, error)
+	DeleteProposalVote(context.Context, *DeleteUserContributionRequest) (*Proposal, error)
+	// Moderation actions on a petition, poll or proposal, newest first.
+	ListModerationHistory(context.Context, *ListModerationHistoryRequest) (*ListModerationHistoryResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListPendingPetitions(context.Context, *ListPendingRequest) (*ListPetitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingPetitions not implemented")
+}
+func (UnimplementedAdminServiceServer) PublishPetition(context.Context, *ModerateRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPetition not implemented")
+}
+func (UnimplementedAdminServiceServer) DeclinePetition(context.Context, *ModerateRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePetition not implemented")
+}
+func (UnimplementedAdminServiceServer) WithdrawPetition(context.Context, *ModerateRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPetition not implemented")
+}
+func (UnimplementedAdminServiceServer) EditPetition(context.Context, *EditContentRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPetition not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePetitionSignature(context.Context, *DeleteUserContributionRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePetitionSignature not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPendingPolls(context.Context, *ListPendingRequest) (*ListPollsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingPolls not implemented")
+}
+func (UnimplementedAdminServiceServer) PublishPoll(context.Context, *ModerateRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPoll not implemented")
+}
+func (UnimplementedAdminServiceServer) DeclinePoll(context.Context, *ModerateRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePoll not implemented")
+}
+func (UnimplementedAdminServiceServer) WithdrawPoll(context.Context, *ModerateRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPoll not implemented")
+}
+func (UnimplementedAdminServiceServer) EditPoll(context.Context, *EditContentRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPoll not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePollVote(context.Context, *DeleteUserContributionRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePollVote not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPendingProposals(context.Context, *ListPendingRequest) (*ListProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingProposals not implemented")
+}
+func (UnimplementedAdminServiceServer) PublishProposal(context.Context, *ModerateRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishProposal not implemented")
+}
+func (UnimplementedAdminServiceServer) DeclineProposal(context.Context, *ModerateRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineProposal not implemented")
+}
+func (UnimplementedAdminServiceServer) WithdrawProposal(context.Context, *ModerateRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProposal not implemented")
+}
+func (UnimplementedAdminServiceServer) EditProposal(context.Context, *EditContentRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditProposal not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteProposalVote(context.Context, *DeleteUserContributionRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProposalVote not implemented")
+}
+func (UnimplementedAdminServiceServer) ListModerationHistory(context.Context, *ListModerationHistoryRequest) (*ListModerationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListPendingPetitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPendingPetitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPendingPetitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPendingPetitions(ctx, req.(*ListPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PublishPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PublishPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PublishPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PublishPetition(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeclinePetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeclinePetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeclinePetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeclinePetition(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WithdrawPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).WithdrawPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_WithdrawPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).WithdrawPetition(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EditPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EditPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EditPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EditPetition(ctx, req.(*EditContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeletePetitionSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeletePetitionSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeletePetitionSignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeletePetitionSignature(ctx, req.(*DeleteUserContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPendingPolls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPendingPolls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPendingPolls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPendingPolls(ctx, req.(*ListPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PublishPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PublishPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PublishPoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PublishPoll(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeclinePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeclinePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeclinePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeclinePoll(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WithdrawPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).WithdrawPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_WithdrawPoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).WithdrawPoll(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EditPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EditPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EditPoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EditPoll(ctx, req.(*EditContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeletePollVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeletePollVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeletePollVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeletePollVote(ctx, req.(*DeleteUserContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPendingProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPendingProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPendingProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPendingProposals(ctx, req.(*ListPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PublishProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PublishProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PublishProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PublishProposal(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeclineProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeclineProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeclineProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeclineProposal(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WithdrawProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).WithdrawProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_WithdrawProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).WithdrawProposal(ctx, req.(*ModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EditProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EditProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EditProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EditProposal(ctx, req.(*EditContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteProposalVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteProposalVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteProposalVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteProposalVote(ctx, req.(*DeleteUserContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListModerationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListModerationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListModerationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListModerationHistory(ctx, req.(*ListModerationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "voting.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPendingPetitions",
+			Handler:    _AdminService_ListPendingPetitions_Handler,
+		},
+		{
+			MethodName: "PublishPetition",
+			Handler:    _AdminService_PublishPetition_Handler,
+		},
+		{
+			MethodName: "DeclinePetition",
+			Handler:    _AdminService_DeclinePetition_Handler,
+		},
+		{
+			MethodName: "WithdrawPetition",
+			Handler:    _AdminService_WithdrawPetition_Handler,
+		},
+		{
+			MethodName: "EditPetition",
+			Handler:    _AdminService_EditPetition_Handler,
+		},
+		{
+			MethodName: "DeletePetitionSignature",
+			Handler:    _AdminService_DeletePetitionSignature_Handler,
+		},
+		{
+			MethodName: "ListPendingPolls",
+			Handler:    _AdminService_ListPendingPolls_Handler,
+		},
+		{
+			MethodName: "PublishPoll",
+			Handler:    _AdminService_PublishPoll_Handler,
+		},
+		{
+			MethodName: "DeclinePoll",
+			Handler:    _AdminService_DeclinePoll_Handler,
+		},
+		{
+			MethodName: "WithdrawPoll",
+			Handler:    _AdminService_WithdrawPoll_Handler,
+		},
+		{
+			MethodName: "EditPoll",
+			Handler:    _AdminService_EditPoll_Handler,
+		},
+		{
+			MethodName: "DeletePollVote",
+			Handler:    _AdminService_DeletePollVote_Handler,
+		},
+		{
+			MethodName: "ListPendingProposals",
+			Handler:    _AdminService_ListPendingProposals_Handler,
+		},
+		{
+			MethodName: "PublishProposal",
+			Handler:    _AdminService_PublishProposal_Handler,
+		},
+		{
+			MethodName: "DeclineProposal",
+			Handler:    _AdminService_DeclineProposal_Handler,
+		},
+		{
+			MethodName: "WithdrawProposal",
+			Handler:    _AdminService_WithdrawProposal_Handler,
+		},
+		{
+			MethodName: "EditProposal",
+			Handler:    _AdminService_EditProposal_Handler,
+		},
+		{
+			MethodName: "DeleteProposalVote",
+			Handler:    _AdminService_DeleteProposalVote_Handler,
+		},
+		{
+			MethodName: "ListModerationHistory",
+			Handler:    _AdminService_ListModerationHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "voting/v1/admin.proto",
+}
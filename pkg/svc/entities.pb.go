@@ -0,0 +1,2293 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: voting/v1/entities.proto
+
+package svc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Point on the map, WGS 84.
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_voting_v1_entities_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Location) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Location) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+type Petition struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CityId      string                 `protobuf:"bytes,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	InitiatorId string                 `protobuf:"bytes,5,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	// Empty when the petition is addressed to the city government.
+	AddressToId string `protobuf:"bytes,6,opt,name=address_to_id,json=addressToId,proto3" json:"address_to_id,omitempty"`
+	// One of: processed, declined, published, withdrawn, approved, rejected, expired, awaiting_response.
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Signatures    int32                  `protobuf:"varint,8,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	Goal          int32                  `protobuf:"varint,9,opt,name=goal,proto3" json:"goal,omitempty"`
+	StopAtGoal    bool                   `protobuf:"varint,10,opt,name=stop_at_goal,json=stopAtGoal,proto3" json:"stop_at_goal,omitempty"`
+	GoalReachedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=goal_reached_at,json=goalReachedAt,proto3" json:"goal_reached_at,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Location      *Location              `protobuf:"bytes,15,opt,name=location,proto3" json:"location,omitempty"`
+	// Set only in full-text search results: relevance and matched fragments with <b> highlighting.
+	Rank          float32 `protobuf:"fixed32,16,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string  `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Petition) Reset() {
+	*x = Petition{}
+	mi := &file_voting_v1_entities_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Petition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Petition) ProtoMessage() {}
+
+func (x *Petition) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Petition.ProtoReflect.Descriptor instead.
+func (*Petition) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Petition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Petition) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *Petition) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Petition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Petition) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *Petition) GetAddressToId() string {
+	if x != nil {
+		return x.AddressToId
+	}
+	return ""
+}
+
+func (x *Petition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Petition) GetSignatures() int32 {
+	if x != nil {
+		return x.Signatures
+	}
+	return 0
+}
+
+func (x *Petition) GetGoal() int32 {
+	if x != nil {
+		return x.Goal
+	}
+	return 0
+}
+
+func (x *Petition) GetStopAtGoal() bool {
+	if x != nil {
+		return x.StopAtGoal
+	}
+	return false
+}
+
+func (x *Petition) GetGoalReachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GoalReachedAt
+	}
+	return nil
+}
+
+func (x *Petition) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Petition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Petition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Petition) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Petition) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Petition) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type PetitionSignature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PetitionId    string                 `protobuf:"bytes,2,opt,name=petition_id,json=petitionId,proto3" json:"petition_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetitionSignature) Reset() {
+	*x = PetitionSignature{}
+	mi := &file_voting_v1_entities_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetitionSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetitionSignature) ProtoMessage() {}
+
+func (x *PetitionSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetitionSignature.ProtoReflect.Descriptor instead.
+func (*PetitionSignature) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PetitionSignature) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PetitionSignature) GetPetitionId() string {
+	if x != nil {
+		return x.PetitionId
+	}
+	return ""
+}
+
+func (x *PetitionSignature) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PetitionSignature) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PollOption struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PollId     string                 `protobuf:"bytes,2,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Text       string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	VotesCount int32                  `protobuf:"varint,4,opt,name=votes_count,json=votesCount,proto3" json:"votes_count,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 0-based place of the option in the poll; options are listed in this order.
+	Position      int32 `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	mi := &file_voting_v1_entities_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PollOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PollOption) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *PollOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOption) GetVotesCount() int32 {
+	if x != nil {
+		return x.VotesCount
+	}
+	return 0
+}
+
+func (x *PollOption) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PollOption) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type Poll struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CityId      string                 `protobuf:"bytes,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// One of: processed, declined, published, withdrawn, closed.
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// One of: choice, ranked, approval, score.
+	Type        string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Secret      bool                   `protobuf:"varint,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	InitiatorId string                 `protobuf:"bytes,8,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	MinChoices  int32                  `protobuf:"varint,9,opt,name=min_choices,json=minChoices,proto3" json:"min_choices,omitempty"`
+	MaxChoices  int32                  `protobuf:"varint,10,opt,name=max_choices,json=maxChoices,proto3" json:"max_choices,omitempty"`
+	MaxScore    int32                  `protobuf:"varint,11,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Options     []*PollOption          `protobuf:"bytes,12,rep,name=options,proto3" json:"options,omitempty"`
+	EndDate     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Location    *Location              `protobuf:"bytes,16,opt,name=location,proto3" json:"location,omitempty"`
+	// Set only in full-text search results: relevance and matched fragments with <b> highlighting.
+	Rank          float32 `protobuf:"fixed32,17,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string  `protobuf:"bytes,18,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_voting_v1_entities_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Poll) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Poll) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *Poll) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Poll) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Poll) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Poll) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Poll) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+func (x *Poll) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *Poll) GetMinChoices() int32 {
+	if x != nil {
+		return x.MinChoices
+	}
+	return 0
+}
+
+func (x *Poll) GetMaxChoices() int32 {
+	if x != nil {
+		return x.MaxChoices
+	}
+	return 0
+}
+
+func (x *Poll) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Poll) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Poll) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Poll) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Poll) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Poll) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+// One entry of a ballot being cast.
+type PollChoice struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OptionId string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	// Required in score polls, must be unset otherwise.
+	Score         *int32 `protobuf:"varint,2,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollChoice) Reset() {
+	*x = PollChoice{}
+	mi := &file_voting_v1_entities_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollChoice) ProtoMessage() {}
+
+func (x *PollChoice) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollChoice.ProtoReflect.Descriptor instead.
+func (*PollChoice) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PollChoice) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *PollChoice) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+type PollVote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PollId        string                 `protobuf:"bytes,2,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	BallotId      string                 `protobuf:"bytes,3,opt,name=ballot_id,json=ballotId,proto3" json:"ballot_id,omitempty"`
+	OptionId      string                 `protobuf:"bytes,4,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Rank          *int32                 `protobuf:"varint,5,opt,name=rank,proto3,oneof" json:"rank,omitempty"`
+	Score         *int32                 `protobuf:"varint,6,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollVote) Reset() {
+	*x = PollVote{}
+	mi := &file_voting_v1_entities_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollVote) ProtoMessage() {}
+
+func (x *PollVote) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollVote.ProtoReflect.Descriptor instead.
+func (*PollVote) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PollVote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PollVote) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *PollVote) GetBallotId() string {
+	if x != nil {
+		return x.BallotId
+	}
+	return ""
+}
+
+func (x *PollVote) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *PollVote) GetRank() int32 {
+	if x != nil && x.Rank != nil {
+		return *x.Rank
+	}
+	return 0
+}
+
+func (x *PollVote) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *PollVote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OptionTally struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionId      string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Votes         int32                  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionTally) Reset() {
+	*x = OptionTally{}
+	mi := &file_voting_v1_entities_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionTally) ProtoMessage() {}
+
+func (x *OptionTally) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionTally.ProtoReflect.Descriptor instead.
+func (*OptionTally) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OptionTally) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *OptionTally) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+type OptionScore struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OptionId  string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Ratings   int32                  `protobuf:"varint,2,opt,name=ratings,proto3" json:"ratings,omitempty"`
+	MeanScore float64                `protobuf:"fixed64,3,opt,name=mean_score,json=meanScore,proto3" json:"mean_score,omitempty"`
+	// distribution[s] is the number of ballots that gave the option score s.
+	Distribution  []int32 `protobuf:"varint,4,rep,packed,name=distribution,proto3" json:"distribution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionScore) Reset() {
+	*x = OptionScore{}
+	mi := &file_voting_v1_entities_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionScore) ProtoMessage() {}
+
+func (x *OptionScore) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionScore.ProtoReflect.Descriptor instead.
+func (*OptionScore) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OptionScore) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *OptionScore) GetRatings() int32 {
+	if x != nil {
+		return x.Ratings
+	}
+	return 0
+}
+
+func (x *OptionScore) GetMeanScore() float64 {
+	if x != nil {
+		return x.MeanScore
+	}
+	return 0
+}
+
+func (x *OptionScore) GetDistribution() []int32 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+type RunoffRound struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Number    int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Tally     []*OptionTally         `protobuf:"bytes,2,rep,name=tally,proto3" json:"tally,omitempty"`
+	Exhausted int32                  `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	// At most one option: ties for the fewest votes are broken by earlier rounds, then by option position.
+	Eliminated    []string `protobuf:"bytes,4,rep,name=eliminated,proto3" json:"eliminated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunoffRound) Reset() {
+	*x = RunoffRound{}
+	mi := &file_voting_v1_entities_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunoffRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunoffRound) ProtoMessage() {}
+
+func (x *RunoffRound) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunoffRound.ProtoReflect.Descriptor instead.
+func (*RunoffRound) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RunoffRound) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *RunoffRound) GetTally() []*OptionTally {
+	if x != nil {
+		return x.Tally
+	}
+	return nil
+}
+
+func (x *RunoffRound) GetExhausted() int32 {
+	if x != nil {
+		return x.Exhausted
+	}
+	return 0
+}
+
+func (x *RunoffRound) GetEliminated() []string {
+	if x != nil {
+		return x.Eliminated
+	}
+	return nil
+}
+
+type RunoffResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Ballots int32                  `protobuf:"varint,1,opt,name=ballots,proto3" json:"ballots,omitempty"`
+	Rounds  []*RunoffRound         `protobuf:"bytes,2,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	Winner  string                 `protobuf:"bytes,3,opt,name=winner,proto3" json:"winner,omitempty"`
+	// Set instead of winner when the remaining options are tied in every round.
+	Tied          []string `protobuf:"bytes,4,rep,name=tied,proto3" json:"tied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunoffResult) Reset() {
+	*x = RunoffResult{}
+	mi := &file_voting_v1_entities_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunoffResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunoffResult) ProtoMessage() {}
+
+func (x *RunoffResult) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunoffResult.ProtoReflect.Descriptor instead.
+func (*RunoffResult) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RunoffResult) GetBallots() int32 {
+	if x != nil {
+		return x.Ballots
+	}
+	return 0
+}
+
+func (x *RunoffResult) GetRounds() []*RunoffRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *RunoffResult) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *RunoffResult) GetTied() []string {
+	if x != nil {
+		return x.Tied
+	}
+	return nil
+}
+
+type MatrixRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []int32                `protobuf:"varint,1,rep,packed,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatrixRow) Reset() {
+	*x = MatrixRow{}
+	mi := &file_voting_v1_entities_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixRow) ProtoMessage() {}
+
+func (x *MatrixRow) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixRow.ProtoReflect.Descriptor instead.
+func (*MatrixRow) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MatrixRow) GetCells() []int32 {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type OptionGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionIds     []string               `protobuf:"bytes,1,rep,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionGroup) Reset() {
+	*x = OptionGroup{}
+	mi := &file_voting_v1_entities_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionGroup) ProtoMessage() {}
+
+func (x *OptionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionGroup.ProtoReflect.Descriptor instead.
+func (*OptionGroup) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OptionGroup) GetOptionIds() []string {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+// Matrices are indexed by the position of the option in options.
+type SchulzeResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Options         []string               `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	Pairwise        []*MatrixRow           `protobuf:"bytes,2,rep,name=pairwise,proto3" json:"pairwise,omitempty"`
+	Strongest       []*MatrixRow           `protobuf:"bytes,3,rep,name=strongest,proto3" json:"strongest,omitempty"`
+	Winners         []string               `protobuf:"bytes,4,rep,name=winners,proto3" json:"winners,omitempty"`
+	Ranking         []*OptionGroup         `protobuf:"bytes,5,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	CondorcetWinner string                 `protobuf:"bytes,6,opt,name=condorcet_winner,json=condorcetWinner,proto3" json:"condorcet_winner,omitempty"`
+	Cycles          []*OptionGroup         `protobuf:"bytes,7,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchulzeResult) Reset() {
+	*x = SchulzeResult{}
+	mi := &file_voting_v1_entities_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchulzeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchulzeResult) ProtoMessage() {}
+
+func (x *SchulzeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchulzeResult.ProtoReflect.Descriptor instead.
+func (*SchulzeResult) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SchulzeResult) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SchulzeResult) GetPairwise() []*MatrixRow {
+	if x != nil {
+		return x.Pairwise
+	}
+	return nil
+}
+
+func (x *SchulzeResult) GetStrongest() []*MatrixRow {
+	if x != nil {
+		return x.Strongest
+	}
+	return nil
+}
+
+func (x *SchulzeResult) GetWinners() []string {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *SchulzeResult) GetRanking() []*OptionGroup {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+func (x *SchulzeResult) GetCondorcetWinner() string {
+	if x != nil {
+		return x.CondorcetWinner
+	}
+	return ""
+}
+
+func (x *SchulzeResult) GetCycles() []*OptionGroup {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+type PollResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Options       []*PollOption          `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Runoff        *RunoffResult          `protobuf:"bytes,4,opt,name=runoff,proto3" json:"runoff,omitempty"`
+	Schulze       *SchulzeResult         `protobuf:"bytes,5,opt,name=schulze,proto3" json:"schulze,omitempty"`
+	Approvals     []*OptionTally         `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Scores        []*OptionScore         `protobuf:"bytes,7,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollResults) Reset() {
+	*x = PollResults{}
+	mi := &file_voting_v1_entities_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollResults) ProtoMessage() {}
+
+func (x *PollResults) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollResults.ProtoReflect.Descriptor instead.
+func (*PollResults) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PollResults) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *PollResults) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PollResults) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PollResults) GetRunoff() *RunoffResult {
+	if x != nil {
+		return x.Runoff
+	}
+	return nil
+}
+
+func (x *PollResults) GetSchulze() *SchulzeResult {
+	if x != nil {
+		return x.Schulze
+	}
+	return nil
+}
+
+func (x *PollResults) GetApprovals() []*OptionTally {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *PollResults) GetScores() []*OptionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type ProposalVerdict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DecidedBy     string                 `protobuf:"bytes,1,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	AgreedNum     int32                  `protobuf:"varint,3,opt,name=agreed_num,json=agreedNum,proto3" json:"agreed_num,omitempty"`
+	DisagreedNum  int32                  `protobuf:"varint,4,opt,name=disagreed_num,json=disagreedNum,proto3" json:"disagreed_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalVerdict) Reset() {
+	*x = ProposalVerdict{}
+	mi := &file_voting_v1_entities_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalVerdict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalVerdict) ProtoMessage() {}
+
+func (x *ProposalVerdict) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalVerdict.ProtoReflect.Descriptor instead.
+func (*ProposalVerdict) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProposalVerdict) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *ProposalVerdict) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *ProposalVerdict) GetAgreedNum() int32 {
+	if x != nil {
+		return x.AgreedNum
+	}
+	return 0
+}
+
+func (x *ProposalVerdict) GetDisagreedNum() int32 {
+	if x != nil {
+		return x.DisagreedNum
+	}
+	return 0
+}
+
+type Proposal struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CityId      string                 `protobuf:"bytes,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// One of: processed, declined, published, withdrawn, approved, rejected, closed.
+	Status      string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	InitiatorId string `protobuf:"bytes,6,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	// Empty when the proposal is addressed to the city government.
+	AddressToId  string                 `protobuf:"bytes,7,opt,name=address_to_id,json=addressToId,proto3" json:"address_to_id,omitempty"`
+	AgreedNum    int32                  `protobuf:"varint,8,opt,name=agreed_num,json=agreedNum,proto3" json:"agreed_num,omitempty"`
+	DisagreedNum int32                  `protobuf:"varint,9,opt,name=disagreed_num,json=disagreedNum,proto3" json:"disagreed_num,omitempty"`
+	EndDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Location     *Location              `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
+	Verdict      *ProposalVerdict       `protobuf:"bytes,14,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	// Set only in full-text search results: relevance and matched fragments with <b> highlighting.
+	Rank          float32 `protobuf:"fixed32,15,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string  `protobuf:"bytes,16,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	mi := &file_voting_v1_entities_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Proposal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Proposal) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *Proposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Proposal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Proposal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Proposal) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *Proposal) GetAddressToId() string {
+	if x != nil {
+		return x.AddressToId
+	}
+	return ""
+}
+
+func (x *Proposal) GetAgreedNum() int32 {
+	if x != nil {
+		return x.AgreedNum
+	}
+	return 0
+}
+
+func (x *Proposal) GetDisagreedNum() int32 {
+	if x != nil {
+		return x.DisagreedNum
+	}
+	return 0
+}
+
+func (x *Proposal) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Proposal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Proposal) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Proposal) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Proposal) GetVerdict() *ProposalVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return nil
+}
+
+func (x *Proposal) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Proposal) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type ProposalVote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProposalId    string                 `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Agree         bool                   `protobuf:"varint,4,opt,name=agree,proto3" json:"agree,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalVote) Reset() {
+	*x = ProposalVote{}
+	mi := &file_voting_v1_entities_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalVote) ProtoMessage() {}
+
+func (x *ProposalVote) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalVote.ProtoReflect.Descriptor instead.
+func (*ProposalVote) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProposalVote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProposalVote) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *ProposalVote) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProposalVote) GetAgree() bool {
+	if x != nil {
+		return x.Agree
+	}
+	return false
+}
+
+func (x *ProposalVote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Map area in WGS 84 degrees.
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinLng        float64                `protobuf:"fixed64,1,opt,name=min_lng,json=minLng,proto3" json:"min_lng,omitempty"`
+	MinLat        float64                `protobuf:"fixed64,2,opt,name=min_lat,json=minLat,proto3" json:"min_lat,omitempty"`
+	MaxLng        float64                `protobuf:"fixed64,3,opt,name=max_lng,json=maxLng,proto3" json:"max_lng,omitempty"`
+	MaxLat        float64                `protobuf:"fixed64,4,opt,name=max_lat,json=maxLat,proto3" json:"max_lat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_voting_v1_entities_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BoundingBox) GetMinLng() float64 {
+	if x != nil {
+		return x.MinLng
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMinLat() float64 {
+	if x != nil {
+		return x.MinLat
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLng() float64 {
+	if x != nil {
+		return x.MaxLng
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLat() float64 {
+	if x != nil {
+		return x.MaxLat
+	}
+	return 0
+}
+
+// Common filters of list requests; empty fields do not filter.
+type ListFilter struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CityId      string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	InitiatorId string                 `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	Status      string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Title       string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// Full-text search over title and description in web search syntax
+	// ("quoted phrase", or, -excluded); results are ordered by relevance.
+	Query string `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	// Language of query: ru, uk or en; empty searches in all of them.
+	// Ukrainian is not stemmed: its words match only in the same form.
+	Language string `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	// Only entities located inside the area.
+	Bbox          *BoundingBox `protobuf:"bytes,7,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilter) Reset() {
+	*x = ListFilter{}
+	mi := &file_voting_v1_entities_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilter) ProtoMessage() {}
+
+func (x *ListFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilter.ProtoReflect.Descriptor instead.
+func (*ListFilter) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListFilter) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *ListFilter) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *ListFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListFilter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListFilter) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListFilter) GetBbox() *BoundingBox {
+	if x != nil {
+		return x.Bbox
+	}
+	return nil
+}
+
+type ModerationEntry struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModeratorId string                 `protobuf:"bytes,2,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	// One of: petition, poll, proposal.
+	EntityType string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// One of: publish, decline, withdraw, edit, delete_signature, delete_vote.
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Author of the removed signature or vote.
+	TargetUserId  string                 `protobuf:"bytes,7,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationEntry) Reset() {
+	*x = ModerationEntry{}
+	mi := &file_voting_v1_entities_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationEntry) ProtoMessage() {}
+
+func (x *ModerationEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationEntry.ProtoReflect.Descriptor instead.
+func (*ModerationEntry) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ModerationEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerationEntry) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *ModerationEntry) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ModerationEntry) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ModerationEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationEntry) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *ModerationEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Live snapshot of the vote counters of a poll.
+type PollTally struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PollId string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	// Ordered by votes_count, most voted first.
+	Options       []*PollOption          `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollTally) Reset() {
+	*x = PollTally{}
+	mi := &file_voting_v1_entities_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollTally) ProtoMessage() {}
+
+func (x *PollTally) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollTally.ProtoReflect.Descriptor instead.
+func (*PollTally) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PollTally) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *PollTally) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PollTally) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Live snapshot of the vote counters of a proposal.
+type ProposalTally struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	AgreedNum     int32                  `protobuf:"varint,2,opt,name=agreed_num,json=agreedNum,proto3" json:"agreed_num,omitempty"`
+	DisagreedNum  int32                  `protobuf:"varint,3,opt,name=disagreed_num,json=disagreedNum,proto3" json:"disagreed_num,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalTally) Reset() {
+	*x = ProposalTally{}
+	mi := &file_voting_v1_entities_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalTally) ProtoMessage() {}
+
+func (x *ProposalTally) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_entities_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalTally.ProtoReflect.Descriptor instead.
+func (*ProposalTally) Descriptor() ([]byte, []int) {
+	return file_voting_v1_entities_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProposalTally) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *ProposalTally) GetAgreedNum() int32 {
+	if x != nil {
+		return x.AgreedNum
+	}
+	return 0
+}
+
+func (x *ProposalTally) GetDisagreedNum() int32 {
+	if x != nil {
+		return x.DisagreedNum
+	}
+	return 0
+}
+
+func (x *ProposalTally) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_voting_v1_entities_proto protoreflect.FileDescriptor
+
+const file_voting_v1_entities_proto_rawDesc = "" +
+	"\n" +
+	"\x18voting/v1/entities.proto\x12\tvoting.v1\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\bLocation\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\"\xf0\x04\n" +
+	"\bPetition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\tR\x06cityId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12!\n" +
+	"\finitiator_id\x18\x05 \x01(\tR\vinitiatorId\x12\"\n" +
+	"\raddress_to_id\x18\x06 \x01(\tR\vaddressToId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"signatures\x18\b \x01(\x05R\n" +
+	"signatures\x12\x12\n" +
+	"\x04goal\x18\t \x01(\x05R\x04goal\x12 \n" +
+	"\fstop_at_goal\x18\n" +
+	" \x01(\bR\n" +
+	"stopAtGoal\x12B\n" +
+	"\x0fgoal_reached_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rgoalReachedAt\x125\n" +
+	"\bend_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\blocation\x18\x0f \x01(\v2\x13.voting.v1.LocationR\blocation\x12\x12\n" +
+	"\x04rank\x18\x10 \x01(\x02R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x11 \x01(\tR\asnippet\"\x98\x01\n" +
+	"\x11PetitionSignature\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vpetition_id\x18\x02 \x01(\tR\n" +
+	"petitionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc1\x01\n" +
+	"\n" +
+	"PollOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apoll_id\x18\x02 \x01(\tR\x06pollId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1f\n" +
+	"\vvotes_count\x18\x04 \x01(\x05R\n" +
+	"votesCount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\"\xea\x04\n" +
+	"\x04Poll\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\tR\x06cityId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x16\n" +
+	"\x06secret\x18\a \x01(\bR\x06secret\x12!\n" +
+	"\finitiator_id\x18\b \x01(\tR\vinitiatorId\x12\x1f\n" +
+	"\vmin_choices\x18\t \x01(\x05R\n" +
+	"minChoices\x12\x1f\n" +
+	"\vmax_choices\x18\n" +
+	" \x01(\x05R\n" +
+	"maxChoices\x12\x1b\n" +
+	"\tmax_score\x18\v \x01(\x05R\bmaxScore\x12/\n" +
+	"\aoptions\x18\f \x03(\v2\x15.voting.v1.PollOptionR\aoptions\x125\n" +
+	"\bend_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\blocation\x18\x10 \x01(\v2\x13.voting.v1.LocationR\blocation\x12\x12\n" +
+	"\x04rank\x18\x11 \x01(\x02R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x12 \x01(\tR\asnippet\"N\n" +
+	"\n" +
+	"PollChoice\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\tR\boptionId\x12\x19\n" +
+	"\x05score\x18\x02 \x01(\x05H\x00R\x05score\x88\x01\x01B\b\n" +
+	"\x06_score\"\xef\x01\n" +
+	"\bPollVote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\apoll_id\x18\x02 \x01(\tR\x06pollId\x12\x1b\n" +
+	"\tballot_id\x18\x03 \x01(\tR\bballotId\x12\x1b\n" +
+	"\toption_id\x18\x04 \x01(\tR\boptionId\x12\x17\n" +
+	"\x04rank\x18\x05 \x01(\x05H\x00R\x04rank\x88\x01\x01\x12\x19\n" +
+	"\x05score\x18\x06 \x01(\x05H\x01R\x05score\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_rankB\b\n" +
+	"\x06_score\"@\n" +
+	"\vOptionTally\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\tR\boptionId\x12\x14\n" +
+	"\x05votes\x18\x02 \x01(\x05R\x05votes\"\x87\x01\n" +
+	"\vOptionScore\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\tR\boptionId\x12\x18\n" +
+	"\aratings\x18\x02 \x01(\x05R\aratings\x12\x1d\n" +
+	"\n" +
+	"mean_score\x18\x03 \x01(\x01R\tmeanScore\x12\"\n" +
+	"\fdistribution\x18\x04 \x03(\x05R\fdistribution\"\x91\x01\n" +
+	"\vRunoffRound\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12,\n" +
+	"\x05tally\x18\x02 \x03(\v2\x16.voting.v1.OptionTallyR\x05tally\x12\x1c\n" +
+	"\texhausted\x18\x03 \x01(\x05R\texhausted\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\x04 \x03(\tR\n" +
+	"eliminated\"\x84\x01\n" +
+	"\fRunoffResult\x12\x18\n" +
+	"\aballots\x18\x01 \x01(\x05R\aballots\x12.\n" +
+	"\x06rounds\x18\x02 \x03(\v2\x16.voting.v1.RunoffRoundR\x06rounds\x12\x16\n" +
+	"\x06winner\x18\x03 \x01(\tR\x06winner\x12\x12\n" +
+	"\x04tied\x18\x04 \x03(\tR\x04tied\"!\n" +
+	"\tMatrixRow\x12\x14\n" +
+	"\x05cells\x18\x01 \x03(\x05R\x05cells\",\n" +
+	"\vOptionGroup\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x01 \x03(\tR\toptionIds\"\xb6\x02\n" +
+	"\rSchulzeResult\x12\x18\n" +
+	"\aoptions\x18\x01 \x03(\tR\aoptions\x120\n" +
+	"\bpairwise\x18\x02 \x03(\v2\x14.voting.v1.MatrixRowR\bpairwise\x122\n" +
+	"\tstrongest\x18\x03 \x03(\v2\x14.voting.v1.MatrixRowR\tstrongest\x12\x18\n" +
+	"\awinners\x18\x04 \x03(\tR\awinners\x120\n" +
+	"\aranking\x18\x05 \x03(\v2\x16.voting.v1.OptionGroupR\aranking\x12)\n" +
+	"\x10condorcet_winner\x18\x06 \x01(\tR\x0fcondorcetWinner\x12.\n" +
+	"\x06cycles\x18\a \x03(\v2\x16.voting.v1.OptionGroupR\x06cycles\"\xb6\x02\n" +
+	"\vPollResults\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12/\n" +
+	"\aoptions\x18\x03 \x03(\v2\x15.voting.v1.PollOptionR\aoptions\x12/\n" +
+	"\x06runoff\x18\x04 \x01(\v2\x17.voting.v1.RunoffResultR\x06runoff\x122\n" +
+	"\aschulze\x18\x05 \x01(\v2\x18.voting.v1.SchulzeResultR\aschulze\x124\n" +
+	"\tapprovals\x18\x06 \x03(\v2\x16.voting.v1.OptionTallyR\tapprovals\x12.\n" +
+	"\x06scores\x18\a \x03(\v2\x16.voting.v1.OptionScoreR\x06scores\"\xaf\x01\n" +
+	"\x0fProposalVerdict\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\x01 \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12\x1d\n" +
+	"\n" +
+	"agreed_num\x18\x03 \x01(\x05R\tagreedNum\x12#\n" +
+	"\rdisagreed_num\x18\x04 \x01(\x05R\fdisagreedNum\"\xd0\x04\n" +
+	"\bProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\tR\x06cityId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12!\n" +
+	"\finitiator_id\x18\x06 \x01(\tR\vinitiatorId\x12\"\n" +
+	"\raddress_to_id\x18\a \x01(\tR\vaddressToId\x12\x1d\n" +
+	"\n" +
+	"agreed_num\x18\b \x01(\x05R\tagreedNum\x12#\n" +
+	"\rdisagreed_num\x18\t \x01(\x05R\fdisagreedNum\x125\n" +
+	"\bend_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\blocation\x18\r \x01(\v2\x13.voting.v1.LocationR\blocation\x124\n" +
+	"\averdict\x18\x0e \x01(\v2\x1a.voting.v1.ProposalVerdictR\averdict\x12\x12\n" +
+	"\x04rank\x18\x0f \x01(\x02R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x10 \x01(\tR\asnippet\"\xa9\x01\n" +
+	"\fProposalVote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vproposal_id\x18\x02 \x01(\tR\n" +
+	"proposalId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05agree\x18\x04 \x01(\bR\x05agree\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"q\n" +
+	"\vBoundingBox\x12\x17\n" +
+	"\amin_lng\x18\x01 \x01(\x01R\x06minLng\x12\x17\n" +
+	"\amin_lat\x18\x02 \x01(\x01R\x06minLat\x12\x17\n" +
+	"\amax_lng\x18\x03 \x01(\x01R\x06maxLng\x12\x17\n" +
+	"\amax_lat\x18\x04 \x01(\x01R\x06maxLat\"\xd4\x01\n" +
+	"\n" +
+	"ListFilter\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12!\n" +
+	"\finitiator_id\x18\x02 \x01(\tR\vinitiatorId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x12*\n" +
+	"\x04bbox\x18\a \x01(\v2\x16.voting.v1.BoundingBoxR\x04bbox\"\x93\x02\n" +
+	"\x0fModerationEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fmoderator_id\x18\x02 \x01(\tR\vmoderatorId\x12\x1f\n" +
+	"\ventity_type\x18\x03 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x04 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12$\n" +
+	"\x0etarget_user_id\x18\a \x01(\tR\ftargetUserId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x01\n" +
+	"\tPollTally\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\x12/\n" +
+	"\aoptions\x18\x02 \x03(\v2\x15.voting.v1.PollOptionR\aoptions\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaf\x01\n" +
+	"\rProposalTally\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x1d\n" +
+	"\n" +
+	"agreed_num\x18\x02 \x01(\x05R\tagreedNum\x12#\n" +
+	"\rdisagreed_num\x18\x03 \x01(\x05R\fdisagreedNum\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB.Z,github.com/chains-lab/voting-svc/pkg/svc;svcb\x06proto3"
+
+var (
+	file_voting_v1_entities_proto_rawDescOnce sync.Once
+	file_voting_v1_entities_proto_rawDescData []byte
+)
+
+func file_voting_v1_entities_proto_rawDescGZIP() []byte {
+	file_voting_v1_entities_proto_rawDescOnce.Do(func() {
+		file_voting_v1_entities_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_voting_v1_entities_proto_rawDesc), len(file_voting_v1_entities_proto_rawDesc)))
+	})
+	return file_voting_v1_entities_proto_rawDescData
+}
+
+var file_voting_v1_entities_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_voting_v1_entities_proto_goTypes = []any{
+	(*Location)(nil),              // 0: voting.v1.Location
+	(*Petition)(nil),              // 1: voting.v1.Petition
+	(*PetitionSignature)(nil),     // 2: voting.v1.PetitionSignature
+	(*PollOption)(nil),            // 3: voting.v1.PollOption
+	(*Poll)(nil),                  // 4: voting.v1.Poll
+	(*PollChoice)(nil),            // 5: voting.v1.PollChoice
+	(*PollVote)(nil),              // 6: voting.v1.PollVote
+	(*OptionTally)(nil),           // 7: voting.v1.OptionTally
+	(*OptionScore)(nil),           // 8: voting.v1.OptionScore
+	(*RunoffRound)(nil),           // 9: voting.v1.RunoffRound
+	(*RunoffResult)(nil),          // 10: voting.v1.RunoffResult
+	(*MatrixRow)(nil),             // 11: voting.v1.MatrixRow
+	(*OptionGroup)(nil),           // 12: voting.v1.OptionGroup
+	(*SchulzeResult)(nil),         // 13: voting.v1.SchulzeResult
+	(*PollResults)(nil),           // 14: voting.v1.PollResults
+	(*ProposalVerdict)(nil),       // 15: voting.v1.ProposalVerdict
+	(*Proposal)(nil),              // 16: voting.v1.Proposal
+	(*ProposalVote)(nil),          // 17: voting.v1.ProposalVote
+	(*BoundingBox)(nil),           // 18: voting.v1.BoundingBox
+	(*ListFilter)(nil),            // 19: voting.v1.ListFilter
+	(*ModerationEntry)(nil),       // 20: voting.v1.ModerationEntry
+	(*PollTally)(nil),             // 21: voting.v1.PollTally
+	(*ProposalTally)(nil),         // 22: voting.v1.ProposalTally
+	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
+}
+var file_voting_v1_entities_proto_depIdxs = []int32{
+	23, // 0: voting.v1.Petition.goal_reached_at:type_name -> google.protobuf.Timestamp
+	23, // 1: voting.v1.Petition.end_date:type_name -> google.protobuf.Timestamp
+	23, // 2: voting.v1.Petition.created_at:type_name -> google.protobuf.Timestamp
+	23, // 3: voting.v1.Petition.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: voting.v1.Petition.location:type_name -> voting.v1.Location
+	23, // 5: voting.v1.PetitionSignature.created_at:type_name -> google.protobuf.Timestamp
+	23, // 6: voting.v1.PollOption.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: voting.v1.Poll.options:type_name -> voting.v1.PollOption
+	23, // 8: voting.v1.Poll.end_date:type_name -> google.protobuf.Timestamp
+	23, // 9: voting.v1.Poll.created_at:type_name -> google.protobuf.Timestamp
+	23, // 10: voting.v1.Poll.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: voting.v1.Poll.location:type_name -> voting.v1.Location
+	23, // 12: voting.v1.PollVote.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 13: voting.v1.RunoffRound.tally:type_name -> voting.v1.OptionTally
+	9,  // 14: voting.v1.RunoffResult.rounds:type_name -> voting.v1.RunoffRound
+	11, // 15: voting.v1.SchulzeResult.pairwise:type_name -> voting.v1.MatrixRow
+	11, // 16: voting.v1.SchulzeResult.strongest:type_name -> voting.v1.MatrixRow
+	12, // 17: voting.v1.SchulzeResult.ranking:type_name -> voting.v1.OptionGroup
+	12, // 18: voting.v1.SchulzeResult.cycles:type_name -> voting.v1.OptionGroup
+	3,  // 19: voting.v1.PollResults.options:type_name -> voting.v1.PollOption
+	10, // 20: voting.v1.PollResults.runoff:type_name -> voting.v1.RunoffResult
+	13, // 21: voting.v1.PollResults.schulze:type_name -> voting.v1.SchulzeResult
+	7,  // 22: voting.v1.PollResults.approvals:type_name -> voting.v1.OptionTally
+	8,  // 23: voting.v1.PollResults.scores:type_name -> voting.v1.OptionScore
+	23, // 24: voting.v1.ProposalVerdict.decided_at:type_name -> google.protobuf.Timestamp
+	23, // 25: voting.v1.Proposal.end_date:type_name -> google.protobuf.Timestamp
+	23, // 26: voting.v1.Proposal.created_at:type_name -> google.protobuf.Timestamp
+	23, // 27: voting.v1.Proposal.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 28: voting.v1.Proposal.location:type_name -> voting.v1.Location
+	15, // 29: voting.v1.Proposal.verdict:type_name -> voting.v1.ProposalVerdict
+	23, // 30: voting.v1.ProposalVote.created_at:type_name -> google.protobuf.Timestamp
+	18, // 31: voting.v1.ListFilter.bbox:type_name -> voting.v1.BoundingBox
+	23, // 32: voting.v1.ModerationEntry.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 33: voting.v1.PollTally.options:type_name -> voting.v1.PollOption
+	23, // 34: voting.v1.PollTally.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 35: voting.v1.ProposalTally.updated_at:type_name -> google.protobuf.Timestamp
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_voting_v1_entities_proto_init() }
+func file_voting_v1_entities_proto_init() {
+	if File_voting_v1_entities_proto != nil {
+		return
+	}
+	file_voting_v1_entities_proto_msgTypes[5].OneofWrappers = []any{}
+	file_voting_v1_entities_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_voting_v1_entities_proto_rawDesc), len(file_voting_v1_entities_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_voting_v1_entities_proto_goTypes,
+		DependencyIndexes: file_voting_v1_entities_proto_depIdxs,
+		MessageInfos:      file_voting_v1_entities_proto_msgTypes,
+	}.Build()
+	File_voting_v1_entities_proto = out.File
+	file_voting_v1_entities_proto_goTypes = nil
+	file_voting_v1_entities_proto_depIdxs = nil
+}
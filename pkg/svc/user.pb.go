@@ -0,0 +1,2280 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: voting/v1/user.proto
+
+package svc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePetitionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CityId      string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Empty to address the city government.
+	AddressToId   string                 `protobuf:"bytes,4,opt,name=address_to_id,json=addressToId,proto3" json:"address_to_id,omitempty"`
+	Goal          int32                  `protobuf:"varint,5,opt,name=goal,proto3" json:"goal,omitempty"`
+	StopAtGoal    bool                   `protobuf:"varint,6,opt,name=stop_at_goal,json=stopAtGoal,proto3" json:"stop_at_goal,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Location      *Location              `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePetitionRequest) Reset() {
+	*x = CreatePetitionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePetitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePetitionRequest) ProtoMessage() {}
+
+func (x *CreatePetitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePetitionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePetitionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePetitionRequest) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *CreatePetitionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePetitionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePetitionRequest) GetAddressToId() string {
+	if x != nil {
+		return x.AddressToId
+	}
+	return ""
+}
+
+func (x *CreatePetitionRequest) GetGoal() int32 {
+	if x != nil {
+		return x.Goal
+	}
+	return 0
+}
+
+func (x *CreatePetitionRequest) GetStopAtGoal() bool {
+	if x != nil {
+		return x.StopAtGoal
+	}
+	return false
+}
+
+func (x *CreatePetitionRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreatePetitionRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetPetitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetitionId    string                 `protobuf:"bytes,1,opt,name=petition_id,json=petitionId,proto3" json:"petition_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPetitionRequest) Reset() {
+	*x = GetPetitionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPetitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetitionRequest) ProtoMessage() {}
+
+func (x *GetPetitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetitionRequest.ProtoReflect.Descriptor instead.
+func (*GetPetitionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPetitionRequest) GetPetitionId() string {
+	if x != nil {
+		return x.PetitionId
+	}
+	return ""
+}
+
+type ListPetitionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *ListFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit  uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page; empty for the first page.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPetitionsRequest) Reset() {
+	*x = ListPetitionsRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPetitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPetitionsRequest) ProtoMessage() {}
+
+func (x *ListPetitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPetitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPetitionsRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPetitionsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPetitionsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPetitionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPetitionsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Petitions []*Petition            `protobuf:"bytes,1,rep,name=petitions,proto3" json:"petitions,omitempty"`
+	// Number of all matches, independent of paging.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Token of the next page; empty on the last one.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPetitionsResponse) Reset() {
+	*x = ListPetitionsResponse{}
+	mi := &file_voting_v1_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPetitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPetitionsResponse) ProtoMessage() {}
+
+func (x *ListPetitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPetitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPetitionsResponse) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPetitionsResponse) GetPetitions() []*Petition {
+	if x != nil {
+		return x.Petitions
+	}
+	return nil
+}
+
+func (x *ListPetitionsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPetitionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SignPetitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetitionId    string                 `protobuf:"bytes,1,opt,name=petition_id,json=petitionId,proto3" json:"petition_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignPetitionRequest) Reset() {
+	*x = SignPetitionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignPetitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPetitionRequest) ProtoMessage() {}
+
+func (x *SignPetitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPetitionRequest.ProtoReflect.Descriptor instead.
+func (*SignPetitionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignPetitionRequest) GetPetitionId() string {
+	if x != nil {
+		return x.PetitionId
+	}
+	return ""
+}
+
+type UnsignPetitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetitionId    string                 `protobuf:"bytes,1,opt,name=petition_id,json=petitionId,proto3" json:"petition_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsignPetitionRequest) Reset() {
+	*x = UnsignPetitionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsignPetitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignPetitionRequest) ProtoMessage() {}
+
+func (x *UnsignPetitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignPetitionRequest.ProtoReflect.Descriptor instead.
+func (*UnsignPetitionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnsignPetitionRequest) GetPetitionId() string {
+	if x != nil {
+		return x.PetitionId
+	}
+	return ""
+}
+
+type GetMySignatureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetitionId    string                 `protobuf:"bytes,1,opt,name=petition_id,json=petitionId,proto3" json:"petition_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMySignatureRequest) Reset() {
+	*x = GetMySignatureRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMySignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMySignatureRequest) ProtoMessage() {}
+
+func (x *GetMySignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMySignatureRequest.ProtoReflect.Descriptor instead.
+func (*GetMySignatureRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMySignatureRequest) GetPetitionId() string {
+	if x != nil {
+		return x.PetitionId
+	}
+	return ""
+}
+
+type WithdrawMyPetitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetitionId    string                 `protobuf:"bytes,1,opt,name=petition_id,json=petitionId,proto3" json:"petition_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawMyPetitionRequest) Reset() {
+	*x = WithdrawMyPetitionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawMyPetitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawMyPetitionRequest) ProtoMessage() {}
+
+func (x *WithdrawMyPetitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawMyPetitionRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawMyPetitionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WithdrawMyPetitionRequest) GetPetitionId() string {
+	if x != nil {
+		return x.PetitionId
+	}
+	return ""
+}
+
+type AnswerPetitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetitionId    string                 `protobuf:"bytes,1,opt,name=petition_id,json=petitionId,proto3" json:"petition_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerPetitionRequest) Reset() {
+	*x = AnswerPetitionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerPetitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerPetitionRequest) ProtoMessage() {}
+
+func (x *AnswerPetitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerPetitionRequest.ProtoReflect.Descriptor instead.
+func (*AnswerPetitionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AnswerPetitionRequest) GetPetitionId() string {
+	if x != nil {
+		return x.PetitionId
+	}
+	return ""
+}
+
+func (x *AnswerPetitionRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type CreatePollRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CityId      string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// One of: choice, ranked, approval, score. Defaults to choice.
+	Type   string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Secret bool   `protobuf:"varint,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Selection limits of choice and ranked polls; 0 picks the default of the type.
+	MinChoices int32 `protobuf:"varint,6,opt,name=min_choices,json=minChoices,proto3" json:"min_choices,omitempty"`
+	MaxChoices int32 `protobuf:"varint,7,opt,name=max_choices,json=maxChoices,proto3" json:"max_choices,omitempty"`
+	// Highest score of a score poll.
+	MaxScore int32 `protobuf:"varint,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// 2..20 options; texts must differ ignoring case.
+	Options       []string               `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Location      *Location              `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePollRequest) Reset() {
+	*x = CreatePollRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePollRequest) ProtoMessage() {}
+
+func (x *CreatePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePollRequest.ProtoReflect.Descriptor instead.
+func (*CreatePollRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreatePollRequest) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *CreatePollRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePollRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePollRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreatePollRequest) GetSecret() bool {
+	if x != nil {
+		return x.Secret
+	}
+	return false
+}
+
+func (x *CreatePollRequest) GetMinChoices() int32 {
+	if x != nil {
+		return x.MinChoices
+	}
+	return 0
+}
+
+func (x *CreatePollRequest) GetMaxChoices() int32 {
+	if x != nil {
+		return x.MaxChoices
+	}
+	return 0
+}
+
+func (x *CreatePollRequest) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *CreatePollRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreatePollRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreatePollRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetPollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPollRequest) Reset() {
+	*x = GetPollRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollRequest) ProtoMessage() {}
+
+func (x *GetPollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollRequest.ProtoReflect.Descriptor instead.
+func (*GetPollRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPollRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+type ListPollsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *ListFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit  uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page; empty for the first page.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPollsRequest) Reset() {
+	*x = ListPollsRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPollsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPollsRequest) ProtoMessage() {}
+
+func (x *ListPollsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPollsRequest.ProtoReflect.Descriptor instead.
+func (*ListPollsRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPollsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPollsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPollsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPollsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Polls []*Poll                `protobuf:"bytes,1,rep,name=polls,proto3" json:"polls,omitempty"`
+	// Number of all matches, independent of paging.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Token of the next page; empty on the last one.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPollsResponse) Reset() {
+	*x = ListPollsResponse{}
+	mi := &file_voting_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPollsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPollsResponse) ProtoMessage() {}
+
+func (x *ListPollsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPollsResponse.ProtoReflect.Descriptor instead.
+func (*ListPollsResponse) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPollsResponse) GetPolls() []*Poll {
+	if x != nil {
+		return x.Polls
+	}
+	return nil
+}
+
+func (x *ListPollsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPollsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type VotePollRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PollId string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	// For ranked polls in order of preference, the most preferred first.
+	Choices       []*PollChoice `protobuf:"bytes,2,rep,name=choices,proto3" json:"choices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VotePollRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *VotePollRequest) GetChoices() []*PollChoice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+type VotePollResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty for secret polls.
+	Votes         []*PollVote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	mi := &file_voting_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VotePollResponse) GetVotes() []*PollVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type RetractPollVoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetractPollVoteRequest) Reset() {
+	*x = RetractPollVoteRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetractPollVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetractPollVoteRequest) ProtoMessage() {}
+
+func (x *RetractPollVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetractPollVoteRequest.ProtoReflect.Descriptor instead.
+func (*RetractPollVoteRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RetractPollVoteRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+type GetMyPollVoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyPollVoteRequest) Reset() {
+	*x = GetMyPollVoteRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyPollVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyPollVoteRequest) ProtoMessage() {}
+
+func (x *GetMyPollVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyPollVoteRequest.ProtoReflect.Descriptor instead.
+func (*GetMyPollVoteRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMyPollVoteRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+type GetMyPollVoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Voted bool                   `protobuf:"varint,1,opt,name=voted,proto3" json:"voted,omitempty"`
+	// Empty for secret polls, where the choice is not linked to the voter.
+	Votes         []*PollVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyPollVoteResponse) Reset() {
+	*x = GetMyPollVoteResponse{}
+	mi := &file_voting_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyPollVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyPollVoteResponse) ProtoMessage() {}
+
+func (x *GetMyPollVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyPollVoteResponse.ProtoReflect.Descriptor instead.
+func (*GetMyPollVoteResponse) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMyPollVoteResponse) GetVoted() bool {
+	if x != nil {
+		return x.Voted
+	}
+	return false
+}
+
+func (x *GetMyPollVoteResponse) GetVotes() []*PollVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type GetPollResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPollResultsRequest) Reset() {
+	*x = GetPollResultsRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsRequest) ProtoMessage() {}
+
+func (x *GetPollResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetPollResultsRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPollResultsRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+type WatchPollTallyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPollTallyRequest) Reset() {
+	*x = WatchPollTallyRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPollTallyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPollTallyRequest) ProtoMessage() {}
+
+func (x *WatchPollTallyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPollTallyRequest.ProtoReflect.Descriptor instead.
+func (*WatchPollTallyRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchPollTallyRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+type WithdrawMyPollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawMyPollRequest) Reset() {
+	*x = WithdrawMyPollRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawMyPollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawMyPollRequest) ProtoMessage() {}
+
+func (x *WithdrawMyPollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawMyPollRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawMyPollRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WithdrawMyPollRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+type AddPollOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPollOptionRequest) Reset() {
+	*x = AddPollOptionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPollOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPollOptionRequest) ProtoMessage() {}
+
+func (x *AddPollOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPollOptionRequest.ProtoReflect.Descriptor instead.
+func (*AddPollOptionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddPollOptionRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *AddPollOptionRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type EditPollOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	OptionId      string                 `protobuf:"bytes,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPollOptionRequest) Reset() {
+	*x = EditPollOptionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPollOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPollOptionRequest) ProtoMessage() {}
+
+func (x *EditPollOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPollOptionRequest.ProtoReflect.Descriptor instead.
+func (*EditPollOptionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EditPollOptionRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *EditPollOptionRequest) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *EditPollOptionRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type RemovePollOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollId        string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	OptionId      string                 `protobuf:"bytes,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePollOptionRequest) Reset() {
+	*x = RemovePollOptionRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePollOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePollOptionRequest) ProtoMessage() {}
+
+func (x *RemovePollOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePollOptionRequest.ProtoReflect.Descriptor instead.
+func (*RemovePollOptionRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemovePollOptionRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *RemovePollOptionRequest) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+type ReorderPollOptionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PollId string                 `protobuf:"bytes,1,opt,name=poll_id,json=pollId,proto3" json:"poll_id,omitempty"`
+	// Every option of the poll exactly once, in the new order.
+	OptionIds     []string `protobuf:"bytes,2,rep,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPollOptionsRequest) Reset() {
+	*x = ReorderPollOptionsRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPollOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPollOptionsRequest) ProtoMessage() {}
+
+func (x *ReorderPollOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPollOptionsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPollOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReorderPollOptionsRequest) GetPollId() string {
+	if x != nil {
+		return x.PollId
+	}
+	return ""
+}
+
+func (x *ReorderPollOptionsRequest) GetOptionIds() []string {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+type CreateProposalRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CityId      string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Empty to address the city government.
+	AddressToId   string                 `protobuf:"bytes,4,opt,name=address_to_id,json=addressToId,proto3" json:"address_to_id,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Location      *Location              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProposalRequest) Reset() {
+	*x = CreateProposalRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProposalRequest) ProtoMessage() {}
+
+func (x *CreateProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProposalRequest.ProtoReflect.Descriptor instead.
+func (*CreateProposalRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateProposalRequest) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *CreateProposalRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateProposalRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProposalRequest) GetAddressToId() string {
+	if x != nil {
+		return x.AddressToId
+	}
+	return ""
+}
+
+func (x *CreateProposalRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateProposalRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProposalRequest) Reset() {
+	*x = GetProposalRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposalRequest) ProtoMessage() {}
+
+func (x *GetProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposalRequest.ProtoReflect.Descriptor instead.
+func (*GetProposalRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type ListProposalsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *ListFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit  uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page; empty for the first page.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProposalsRequest) Reset() {
+	*x = ListProposalsRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsRequest) ProtoMessage() {}
+
+func (x *ListProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListProposalsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListProposalsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListProposalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListProposalsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Proposals []*Proposal            `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// Number of all matches, independent of paging.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Token of the next page; empty on the last one.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProposalsResponse) Reset() {
+	*x = ListProposalsResponse{}
+	mi := &file_voting_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsResponse) ProtoMessage() {}
+
+func (x *ListProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListProposalsResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+func (x *ListProposalsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProposalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type VoteProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Agree         bool                   `protobuf:"varint,2,opt,name=agree,proto3" json:"agree,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteProposalRequest) Reset() {
+	*x = VoteProposalRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteProposalRequest) ProtoMessage() {}
+
+func (x *VoteProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteProposalRequest.ProtoReflect.Descriptor instead.
+func (*VoteProposalRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VoteProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *VoteProposalRequest) GetAgree() bool {
+	if x != nil {
+		return x.Agree
+	}
+	return false
+}
+
+type RetractProposalVoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetractProposalVoteRequest) Reset() {
+	*x = RetractProposalVoteRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetractProposalVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetractProposalVoteRequest) ProtoMessage() {}
+
+func (x *RetractProposalVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetractProposalVoteRequest.ProtoReflect.Descriptor instead.
+func (*RetractProposalVoteRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RetractProposalVoteRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type GetMyProposalVoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyProposalVoteRequest) Reset() {
+	*x = GetMyProposalVoteRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyProposalVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyProposalVoteRequest) ProtoMessage() {}
+
+func (x *GetMyProposalVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyProposalVoteRequest.ProtoReflect.Descriptor instead.
+func (*GetMyProposalVoteRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMyProposalVoteRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type WatchProposalTallyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProposalTallyRequest) Reset() {
+	*x = WatchProposalTallyRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProposalTallyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProposalTallyRequest) ProtoMessage() {}
+
+func (x *WatchProposalTallyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProposalTallyRequest.ProtoReflect.Descriptor instead.
+func (*WatchProposalTallyRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchProposalTallyRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type WithdrawMyProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawMyProposalRequest) Reset() {
+	*x = WithdrawMyProposalRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawMyProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawMyProposalRequest) ProtoMessage() {}
+
+func (x *WithdrawMyProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawMyProposalRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawMyProposalRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WithdrawMyProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type AnswerProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerProposalRequest) Reset() {
+	*x = AnswerProposalRequest{}
+	mi := &file_voting_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerProposalRequest) ProtoMessage() {}
+
+func (x *AnswerProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerProposalRequest.ProtoReflect.Descriptor instead.
+func (*AnswerProposalRequest) Descriptor() ([]byte, []int) {
+	return file_voting_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AnswerProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *AnswerProposalRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+var File_voting_v1_user_proto protoreflect.FileDescriptor
+
+const file_voting_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x14voting/v1/user.proto\x12\tvoting.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18voting/v1/entities.proto\"\xaa\x02\n" +
+	"\x15CreatePetitionRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
+	"\raddress_to_id\x18\x04 \x01(\tR\vaddressToId\x12\x12\n" +
+	"\x04goal\x18\x05 \x01(\x05R\x04goal\x12 \n" +
+	"\fstop_at_goal\x18\x06 \x01(\bR\n" +
+	"stopAtGoal\x125\n" +
+	"\bend_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12/\n" +
+	"\blocation\x18\b \x01(\v2\x13.voting.v1.LocationR\blocation\"5\n" +
+	"\x12GetPetitionRequest\x12\x1f\n" +
+	"\vpetition_id\x18\x01 \x01(\tR\n" +
+	"petitionId\"\x88\x01\n" +
+	"\x14ListPetitionsRequest\x12-\n" +
+	"\x06filter\x18\x01 \x01(\v2\x15.voting.v1.ListFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06offset\"\x88\x01\n" +
+	"\x15ListPetitionsResponse\x121\n" +
+	"\tpetitions\x18\x01 \x03(\v2\x13.voting.v1.PetitionR\tpetitions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"6\n" +
+	"\x13SignPetitionRequest\x12\x1f\n" +
+	"\vpetition_id\x18\x01 \x01(\tR\n" +
+	"petitionId\"8\n" +
+	"\x15UnsignPetitionRequest\x12\x1f\n" +
+	"\vpetition_id\x18\x01 \x01(\tR\n" +
+	"petitionId\"8\n" +
+	"\x15GetMySignatureRequest\x12\x1f\n" +
+	"\vpetition_id\x18\x01 \x01(\tR\n" +
+	"petitionId\"<\n" +
+	"\x19WithdrawMyPetitionRequest\x12\x1f\n" +
+	"\vpetition_id\x18\x01 \x01(\tR\n" +
+	"petitionId\"R\n" +
+	"\x15AnswerPetitionRequest\x12\x1f\n" +
+	"\vpetition_id\x18\x01 \x01(\tR\n" +
+	"petitionId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\"\xf1\x02\n" +
+	"\x11CreatePollRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\bR\x06secret\x12\x1f\n" +
+	"\vmin_choices\x18\x06 \x01(\x05R\n" +
+	"minChoices\x12\x1f\n" +
+	"\vmax_choices\x18\a \x01(\x05R\n" +
+	"maxChoices\x12\x1b\n" +
+	"\tmax_score\x18\b \x01(\x05R\bmaxScore\x12\x18\n" +
+	"\aoptions\x18\t \x03(\tR\aoptions\x125\n" +
+	"\bend_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12/\n" +
+	"\blocation\x18\v \x01(\v2\x13.voting.v1.LocationR\blocation\")\n" +
+	"\x0eGetPollRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\"\x84\x01\n" +
+	"\x10ListPollsRequest\x12-\n" +
+	"\x06filter\x18\x01 \x01(\v2\x15.voting.v1.ListFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06offset\"x\n" +
+	"\x11ListPollsResponse\x12%\n" +
+	"\x05polls\x18\x01 \x03(\v2\x0f.voting.v1.PollR\x05polls\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"[\n" +
+	"\x0fVotePollRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\x12/\n" +
+	"\achoices\x18\x02 \x03(\v2\x15.voting.v1.PollChoiceR\achoices\"=\n" +
+	"\x10VotePollResponse\x12)\n" +
+	"\x05votes\x18\x01 \x03(\v2\x13.voting.v1.PollVoteR\x05votes\"1\n" +
+	"\x16RetractPollVoteRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\"/\n" +
+	"\x14GetMyPollVoteRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\"X\n" +
+	"\x15GetMyPollVoteResponse\x12\x14\n" +
+	"\x05voted\x18\x01 \x01(\bR\x05voted\x12)\n" +
+	"\x05votes\x18\x02 \x03(\v2\x13.voting.v1.PollVoteR\x05votes\"0\n" +
+	"\x15GetPollResultsRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\"0\n" +
+	"\x15WatchPollTallyRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\"0\n" +
+	"\x15WithdrawMyPollRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\"C\n" +
+	"\x14AddPollOptionRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"a\n" +
+	"\x15EditPollOptionRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\x12\x1b\n" +
+	"\toption_id\x18\x02 \x01(\tR\boptionId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"O\n" +
+	"\x17RemovePollOptionRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\x12\x1b\n" +
+	"\toption_id\x18\x02 \x01(\tR\boptionId\"S\n" +
+	"\x19ReorderPollOptionsRequest\x12\x17\n" +
+	"\apoll_id\x18\x01 \x01(\tR\x06pollId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x02 \x03(\tR\toptionIds\"\xf4\x01\n" +
+	"\x15CreateProposalRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
+	"\raddress_to_id\x18\x04 \x01(\tR\vaddressToId\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12/\n" +
+	"\blocation\x18\x06 \x01(\v2\x13.voting.v1.LocationR\blocation\"5\n" +
+	"\x12GetProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\"\x88\x01\n" +
+	"\x14ListProposalsRequest\x12-\n" +
+	"\x06filter\x18\x01 \x01(\v2\x15.voting.v1.ListFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06offset\"\x88\x01\n" +
+	"\x15ListProposalsResponse\x121\n" +
+	"\tproposals\x18\x01 \x03(\v2\x13.voting.v1.ProposalR\tproposals\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"L\n" +
+	"\x13VoteProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x14\n" +
+	"\x05agree\x18\x02 \x01(\bR\x05agree\"=\n" +
+	"\x1aRetractProposalVoteRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\";\n" +
+	"\x18GetMyProposalVoteRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\"<\n" +
+	"\x19WatchProposalTallyRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\"<\n" +
+	"\x19WithdrawMyProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\"R\n" +
+	"\x15AnswerProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove2\xc9\x11\n" +
+	"\vUserService\x12G\n" +
+	"\x0eCreatePetition\x12 .voting.v1.CreatePetitionRequest\x1a\x13.voting.v1.Petition\x12A\n" +
+	"\vGetPetition\x12\x1d.voting.v1.GetPetitionRequest\x1a\x13.voting.v1.Petition\x12R\n" +
+	"\rListPetitions\x12\x1f.voting.v1.ListPetitionsRequest\x1a .voting.v1.ListPetitionsResponse\x12C\n" +
+	"\fSignPetition\x12\x1e.voting.v1.SignPetitionRequest\x1a\x13.voting.v1.Petition\x12G\n" +
+	"\x0eUnsignPetition\x12 .voting.v1.UnsignPetitionRequest\x1a\x13.voting.v1.Petition\x12P\n" +
+	"\x0eGetMySignature\x12 .voting.v1.GetMySignatureRequest\x1a\x1c.voting.v1.PetitionSignature\x12O\n" +
+	"\x12WithdrawMyPetition\x12$.voting.v1.WithdrawMyPetitionRequest\x1a\x13.voting.v1.Petition\x12G\n" +
+	"\x0eAnswerPetition\x12 .voting.v1.AnswerPetitionRequest\x1a\x13.voting.v1.Petition\x12;\n" +
+	"\n" +
+	"CreatePoll\x12\x1c.voting.v1.CreatePollRequest\x1a\x0f.voting.v1.Poll\x125\n" +
+	"\aGetPoll\x12\x19.voting.v1.GetPollRequest\x1a\x0f.voting.v1.Poll\x12F\n" +
+	"\tListPolls\x12\x1b.voting.v1.ListPollsRequest\x1a\x1c.voting.v1.ListPollsResponse\x12C\n" +
+	"\bVotePoll\x12\x1a.voting.v1.VotePollRequest\x1a\x1b.voting.v1.VotePollResponse\x12L\n" +
+	"\x0fRetractPollVote\x12!.voting.v1.RetractPollVoteRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\rGetMyPollVote\x12\x1f.voting.v1.GetMyPollVoteRequest\x1a .voting.v1.GetMyPollVoteResponse\x12J\n" +
+	"\x0eGetPollResults\x12 .voting.v1.GetPollResultsRequest\x1a\x16.voting.v1.PollResults\x12J\n" +
+	"\x0eWatchPollTally\x12 .voting.v1.WatchPollTallyRequest\x1a\x14.voting.v1.PollTally0\x01\x12C\n" +
+	"\x0eWithdrawMyPoll\x12 .voting.v1.WithdrawMyPollRequest\x1a\x0f.voting.v1.Poll\x12A\n" +
+	"\rAddPollOption\x12\x1f.voting.v1.AddPollOptionRequest\x1a\x0f.voting.v1.Poll\x12C\n" +
+	"\x0eEditPollOption\x12 .voting.v1.EditPollOptionRequest\x1a\x0f.voting.v1.Poll\x12G\n" +
+	"\x10RemovePollOption\x12\".voting.v1.RemovePollOptionRequest\x1a\x0f.voting.v1.Poll\x12K\n" +
+	"\x12ReorderPollOptions\x12$.voting.v1.ReorderPollOptionsRequest\x1a\x0f.voting.v1.Poll\x12G\n" +
+	"\x0eCreateProposal\x12 .voting.v1.CreateProposalRequest\x1a\x13.voting.v1.Proposal\x12A\n" +
+	"\vGetProposal\x12\x1d.voting.v1.GetProposalRequest\x1a\x13.voting.v1.Proposal\x12R\n" +
+	"\rListProposals\x12\x1f.voting.v1.ListProposalsRequest\x1a .voting.v1.ListProposalsResponse\x12G\n" +
+	"\fVoteProposal\x12\x1e.voting.v1.VoteProposalRequest\x1a\x17.voting.v1.ProposalVote\x12T\n" +
+	"\x13RetractProposalVote\x12%.voting.v1.RetractProposalVoteRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x11GetMyProposalVote\x12#.voting.v1.GetMyProposalVoteRequest\x1a\x17.voting.v1.ProposalVote\x12V\n" +
+	"\x12WatchProposalTally\x12$.voting.v1.WatchProposalTallyRequest\x1a\x18.voting.v1.ProposalTally0\x01\x12O\n" +
+	"\x12WithdrawMyProposal\x12$.voting.v1.WithdrawMyProposalRequest\x1a\x13.voting.v1.Proposal\x12G\n" +
+	"\x0eAnswerProposal\x12 .voting.v1.AnswerProposalRequest\x1a\x13.voting.v1.ProposalB.Z,github.com/chains-lab/voting-svc/pkg/svc;svcb\x06proto3"
+
+var (
+	file_voting_v1_user_proto_rawDescOnce sync.Once
+	file_voting_v1_user_proto_rawDescData []byte
+)
+
+func file_voting_v1_user_proto_rawDescGZIP() []byte {
+	file_voting_v1_user_proto_rawDescOnce.Do(func() {
+		file_voting_v1_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_voting_v1_user_proto_rawDesc), len(file_voting_v1_user_proto_rawDesc)))
+	})
+	return file_voting_v1_user_proto_rawDescData
+}
+
+var file_voting_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_voting_v1_user_proto_goTypes = []any{
+	(*CreatePetitionRequest)(nil),      // 0: voting.v1.CreatePetitionRequest
+	(*GetPetitionRequest)(nil),         // 1: voting.v1.GetPetitionRequest
+	(*ListPetitionsRequest)(nil),       // 2: voting.v1.ListPetitionsRequest
+	(*ListPetitionsResponse)(nil),      // 3: voting.v1.ListPetitionsResponse
+	(*SignPetitionRequest)(nil),        // 4: voting.v1.SignPetitionRequest
+	(*UnsignPetitionRequest)(nil),      // 5: voting.v1.UnsignPetitionRequest
+	(*GetMySignatureRequest)(nil),      // 6: voting.v1.GetMySignatureRequest
+	(*WithdrawMyPetitionRequest)(nil),  // 7: voting.v1.WithdrawMyPetitionRequest
+	(*AnswerPetitionRequest)(nil),      // 8: voting.v1.AnswerPetitionRequest
+	(*CreatePollRequest)(nil),          // 9: voting.v1.CreatePollRequest
+	(*GetPollRequest)(nil),             // 10: voting.v1.GetPollRequest
+	(*ListPollsRequest)(nil),           // 11: voting.v1.ListPollsRequest
+	(*ListPollsResponse)(nil),          // 12: voting.v1.ListPollsResponse
+	(*VotePollRequest)(nil),            // 13: voting.v1.VotePollRequest
+	(*VotePollResponse)(nil),           // 14: voting.v1.VotePollResponse
+	(*RetractPollVoteRequest)(nil),     // 15: voting.v1.RetractPollVoteRequest
+	(*GetMyPollVoteRequest)(nil),       // 16: voting.v1.GetMyPollVoteRequest
+	(*GetMyPollVoteResponse)(nil),      // 17: voting.v1.GetMyPollVoteResponse
+	(*GetPollResultsRequest)(nil),      // 18: voting.v1.GetPollResultsRequest
+	(*WatchPollTallyRequest)(nil),      // 19: voting.v1.WatchPollTallyRequest
+	(*WithdrawMyPollRequest)(nil),      // 20: voting.v1.WithdrawMyPollRequest
+	(*AddPollOptionRequest)(nil),       // 21: voting.v1.AddPollOptionRequest
+	(*EditPollOptionRequest)(nil),      // 22: voting.v1.EditPollOptionRequest
+	(*RemovePollOptionRequest)(nil),    // 23: voting.v1.RemovePollOptionRequest
+	(*ReorderPollOptionsRequest)(nil),  // 24: voting.v1.ReorderPollOptionsRequest
+	(*CreateProposalRequest)(nil),      // 25: voting.v1.CreateProposalRequest
+	(*GetProposalRequest)(nil),         // 26: voting.v1.GetProposalRequest
+	(*ListProposalsRequest)(nil),       // 27: voting.v1.ListProposalsRequest
+	(*ListProposalsResponse)(nil),      // 28: voting.v1.ListProposalsResponse
+	(*VoteProposalRequest)(nil),        // 29: voting.v1.VoteProposalRequest
+	(*RetractProposalVoteRequest)(nil), // 30: voting.v1.RetractProposalVoteRequest
+	(*GetMyProposalVoteRequest)(nil),   // 31: voting.v1.GetMyProposalVoteRequest
+	(*WatchProposalTallyRequest)(nil),  // 32: voting.v1.WatchProposalTallyRequest
+	(*WithdrawMyProposalRequest)(nil),  // 33: voting.v1.WithdrawMyProposalRequest
+	(*AnswerProposalRequest)(nil),      // 34: voting.v1.AnswerProposalRequest
+	(*timestamppb.Timestamp)(nil),      // 35: google.protobuf.Timestamp
+	(*Location)(nil),                   // 36: voting.v1.Location
+	(*ListFilter)(nil),                 // 37: voting.v1.ListFilter
+	(*Petition)(nil),                   // 38: voting.v1.Petition
+	(*Poll)(nil),                       // 39: voting.v1.Poll
+	(*PollChoice)(nil),                 // 40: voting.v1.PollChoice
+	(*PollVote)(nil),                   // 41: voting.v1.PollVote
+	(*Proposal)(nil),                   // 42: voting.v1.Proposal
+	(*PetitionSignature)(nil),          // 43: voting.v1.PetitionSignature
+	(*emptypb.Empty)(nil),              // 44: google.protobuf.Empty
+	(*PollResults)(nil),                // 45: voting.v1.PollResults
+	(*PollTally)(nil),                  // 46: voting.v1.PollTally
+	(*ProposalVote)(nil),               // 47: voting.v1.ProposalVote
+	(*ProposalTally)(nil),              // 48: voting.v1.ProposalTally
+}
+var file_voting_v1_user_proto_depIdxs = []int32{
+	35, // 0: voting.v1.CreatePetitionRequest.end_date:type_name -> google.protobuf.Timestamp
+	36, // 1: voting.v1.CreatePetitionRequest.location:type_name -> voting.v1.Location
+	37, // 2: voting.v1.ListPetitionsRequest.filter:type_name -> voting.v1.ListFilter
+	38, // 3: voting.v1.ListPetitionsResponse.petitions:type_name -> voting.v1.Petition
+	35, // 4: voting.v1.CreatePollRequest.end_date:type_name -> google.protobuf.Timestamp
+	36, // 5: voting.v1.CreatePollRequest.location:type_name -> voting.v1.Location
+	37, // 6: voting.v1.ListPollsRequest.filter:type_name -> voting.v1.ListFilter
+	39, // 7: voting.v1.ListPollsResponse.polls:type_name -> voting.v1.Poll
+	40, // 8: voting.v1.VotePollRequest.choices:type_name -> voting.v1.PollChoice
+	41, // 9: voting.v1.VotePollResponse.votes:type_name -> voting.v1.PollVote
+	41, // 10: voting.v1.GetMyPollVoteResponse.votes:type_name -> voting.v1.PollVote
+	35, // 11: voting.v1.CreateProposalRequest.end_date:type_name -> google.protobuf.Timestamp
+	36, // 12: voting.v1.CreateProposalRequest.location:type_name -> voting.v1.Location
+	37, // 13: voting.v1.ListProposalsRequest.filter:type_name -> voting.v1.ListFilter
+	42, // 14: voting.v1.ListProposalsResponse.proposals:type_name -> voting.v1.Proposal
+	0,  // 15: voting.v1.UserService.CreatePetition:input_type -> voting.v1.CreatePetitionRequest
+	1,  // 16: voting.v1.UserService.GetPetition:input_type -> voting.v1.GetPetitionRequest
+	2,  // 17: voting.v1.UserService.ListPetitions:input_type -> voting.v1.ListPetitionsRequest
+	4,  // 18: voting.v1.UserService.SignPetition:input_type -> voting.v1.SignPetitionRequest
+	5,  // 19: voting.v1.UserService.UnsignPetition:input_type -> voting.v1.UnsignPetitionRequest
+	6,  // 20: voting.v1.UserService.GetMySignature:input_type -> voting.v1.GetMySignatureRequest
+	7,  // 21: voting.v1.UserService.WithdrawMyPetition:input_type -> voting.v1.WithdrawMyPetitionRequest
+	8,  // 22: voting.v1.UserService.AnswerPetition:input_type -> voting.v1.AnswerPetitionRequest
+	9,  // 23: voting.v1.UserService.CreatePoll:input_type -> voting.v1.CreatePollRequest
+	10, // 24: voting.v1.UserService.GetPoll:input_type -> voting.v1.GetPollRequest
+	11, // 25: voting.v1.UserService.ListPolls:input_type -> voting.v1.ListPollsRequest
+	13, // 26: voting.v1.UserService.VotePoll:input_type -> voting.v1.VotePollRequest
+	15, // 27: voting.v1.UserService.RetractPollVote:input_type -> voting.v1.RetractPollVoteRequest
+	16, // 28: voting.v1.UserService.GetMyPollVote:input_type -> voting.v1.GetMyPollVoteRequest
+	18, // 29: voting.v1.UserService.GetPollResults:input_type -> voting.v1.GetPollResultsRequest
+	19, // 30: voting.v1.UserService.WatchPollTally:input_type -> voting.v1.WatchPollTallyRequest
+	20, // 31: voting.v1.UserService.WithdrawMyPoll:input_type -> voting.v1.WithdrawMyPollRequest
+	21, // 32: voting.v1.UserService.AddPollOption:input_type -> voting.v1.AddPollOptionRequest
+	22, // 33: voting.v1.UserService.EditPollOption:input_type -> voting.v1.EditPollOptionRequest
+	23, // 34: voting.v1.UserService.RemovePollOption:input_type -> voting.v1.RemovePollOptionRequest
+	24, // 35: voting.v1.UserService.ReorderPollOptions:input_type -> voting.v1.ReorderPollOptionsRequest
+	25, // 36: voting.v1.UserService.CreateProposal:input_type -> voting.v1.CreateProposalRequest
+	26, // 37: voting.v1.UserService.GetProposal:input_type -> voting.v1.GetProposalRequest
+	27, // 38: voting.v1.UserService.ListProposals:input_type -> voting.v1.ListProposalsRequest
+	29, // 39: voting.v1.UserService.VoteProposal:input_type -> voting.v1.VoteProposalRequest
+	30, // 40: voting.v1.UserService.RetractProposalVote:input_type -> voting.v1.RetractProposalVoteRequest
+	31, // 41: voting.v1.UserService.GetMyProposalVote:input_type -> voting.v1.GetMyProposalVoteRequest
+	32, // 42: voting.v1.UserService.WatchProposalTally:input_type -> voting.v1.WatchProposalTallyRequest
+	33, // 43: voting.v1.UserService.WithdrawMyProposal:input_type -> voting.v1.WithdrawMyProposalRequest
+	34, // 44: voting.v1.UserService.AnswerProposal:input_type -> voting.v1.AnswerProposalRequest
+	38, // 45: voting.v1.UserService.CreatePetition:output_type -> voting.v1.Petition
+	38, // 46: voting.v1.UserService.GetPetition:output_type -> voting.v1.Petition
+	3,  // 47: voting.v1.UserService.ListPetitions:output_type -> voting.v1.ListPetitionsResponse
+	38, // 48: voting.v1.UserService.SignPetition:output_type -> voting.v1.Petition
+	38, // 49: voting.v1.UserService.UnsignPetition:output_type -> voting.v1.Petition
+	43, // 50: voting.v1.UserService.GetMySignature:output_type -> voting.v1.PetitionSignature
+	38, // 51: voting.v1.UserService.WithdrawMyPetition:output_type -> voting.v1.Petition
+	38, // 52: voting.v1.UserService.AnswerPetition:output_type -> voting.v1.Petition
+	39, // 53: voting.v1.UserService.CreatePoll:output_type -> voting.v1.Poll
+	39, // 54: voting.v1.UserService.GetPoll:output_type -> voting.v1.Poll
+	12, // 55: voting.v1.UserService.ListPolls:output_type -> voting.v1.ListPollsResponse
+	14, // 56: voting.v1.UserService.VotePoll:output_type -> voting.v1.VotePollResponse
+	44, // 57: voting.v1.UserService.RetractPollVote:output_type -> google.protobuf.Empty
+	17, // 58: voting.v1.UserService.GetMyPollVote:output_type -> voting.v1.GetMyPollVoteResponse
+	45, // 59: voting.v1.UserService.GetPollResults:output_type -> voting.v1.PollResults
+	46, // 60: voting.v1.UserService.WatchPollTally:output_type -> voting.v1.PollTally
+	39, // 61: voting.v1.UserService.WithdrawMyPoll:output_type -> voting.v1.Poll
+	39, // 62: voting.v1.UserService.AddPollOption:output_type -> voting.v1.Poll
+	39, // 63: voting.v1.UserService.EditPollOption:output_type -> voting.v1.Poll
+	39, // 64: voting.v1.UserService.RemovePollOption:output_type -> voting.v1.Poll
+	39, // 65: voting.v1.UserService.ReorderPollOptions:output_type -> voting.v1.Poll
+	42, // 66: voting.v1.UserService.CreateProposal:output_type -> voting.v1.Proposal
+	42, // 67: voting.v1.UserService.GetProposal:output_type -> voting.v1.Proposal
+	28, // 68: voting.v1.UserService.ListProposals:output_type -> voting.v1.ListProposalsResponse
+	47, // 69: voting.v1.UserService.VoteProposal:output_type -> voting.v1.ProposalVote
+	44, // 70: voting.v1.UserService.RetractProposalVote:output_type -> google.protobuf.Empty
+	47, // 71: voting.v1.UserService.GetMyProposalVote:output_type -> voting.v1.ProposalVote
+	48, // 72: voting.v1.UserService.WatchProposalTally:output_type -> voting.v1.ProposalTally
+	42, // 73: voting.v1.UserService.WithdrawMyProposal:output_type -> voting.v1.Proposal
+	42, // 74: voting.v1.UserService.AnswerProposal:output_type -> voting.v1.Proposal
+	45, // [45:75] is the sub-list for method output_type
+	15, // [15:45] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_voting_v1_user_proto_init() }
+func file_voting_v1_user_proto_init() {
+	if File_voting_v1_user_proto != nil {
+		return
+	}
+	file_voting_v1_entities_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_voting_v1_user_proto_rawDesc), len(file_voting_v1_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   35,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_voting_v1_user_proto_goTypes,
+		DependencyIndexes: file_voting_v1_user_proto_depIdxs,
+		MessageInfos:      file_voting_v1_user_proto_msgTypes,
+	}.Build()
+	File_voting_v1_user_proto = out.File
+	file_voting_v1_user_proto_goTypes = nil
+	file_voting_v1_user_proto_depIdxs = nil
+}
@@ -0,0 +1,1271 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: voting/v1/user.proto
+
+package svc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserService_CreatePetition_FullMethodName      = "/voting.v1.UserService/CreatePetition"
+	UserService_GetPetition_FullMethodName         = "/voting.v1.UserService/GetPetition"
+	UserService_ListPetitions_FullMethodName       = "/voting.v1.UserService/ListPetitions"
+	UserService_SignPetition_FullMethodName        = "/voting.v1.UserService/SignPetition"
+	UserService_UnsignPetition_FullMethodName      = "/voting.v1.UserService/UnsignPetition"
+	UserService_GetMySignature_FullMethodName      = "/voting.v1.UserService/GetMySignature"
+	UserService_WithdrawMyPetition_FullMethodName  = "/voting.v1.UserService/WithdrawMyPetition"
+	UserService_AnswerPetition_FullMethodName      = "/voting.v1.UserService/AnswerPetition"
+	UserService_CreatePoll_FullMethodName          = "/voting.v1.UserService/CreatePoll"
+	UserService_GetPoll_FullMethodName             = "/voting.v1.UserService/GetPoll"
+	UserService_ListPolls_FullMethodName           = "/voting.v1.UserService/ListPolls"
+	UserService_VotePoll_FullMethodName            = "/voting.v1.UserService/VotePoll"
+	UserService_RetractPollVote_FullMethodName     = "/voting.v1.UserService/RetractPollVote"
+	UserService_GetMyPollVote_FullMethodName       = "/voting.v1.UserService/GetMyPollVote"
+	UserService_GetPollResults_FullMethodName      = "/voting.v1.UserService/GetPollResults"
+	UserService_WatchPollTally_FullMethodName      = "/voting.v1.UserService/WatchPollTally"
+	UserService_WithdrawMyPoll_FullMethodName      = "/voting.v1.UserService/WithdrawMyPoll"
+	UserService_AddPollOption_FullMethodName       = "/voting.v1.UserService/AddPollOption"
+	UserService_EditPollOption_FullMethodName      = "/voting.v1.UserService/EditPollOption"
+	UserService_RemovePollOption_FullMethodName    = "/voting.v1.UserService/RemovePollOption"
+	UserService_ReorderPollOptions_FullMethodName  = "/voting.v1.UserService/ReorderPollOptions"
+	UserService_CreateProposal_FullMethodName      = "/voting.v1.UserService/CreateProposal"
+	UserService_GetProposal_FullMethodName         = "/voting.v1.UserService/GetProposal"
+	UserService_ListProposals_FullMethodName       = "/voting.v1.UserService/ListProposals"
+	UserService_VoteProposal_FullMethodName        = "/voting.v1.UserService/VoteProposal"
+	UserService_RetractProposalVote_FullMethodName = "/voting.v1.UserService/RetractProposalVote"
+	UserService_GetMyProposalVote_FullMethodName   = "/voting.v1.UserService/GetMyProposalVote"
+	UserService_WatchProposalTally_FullMethodName  = "/voting.v1.UserService/WatchProposalTally"
+	UserService_WithdrawMyProposal_FullMethodName  = "/voting.v1.UserService/WithdrawMyProposal"
+	UserService_AnswerProposal_FullMethodName      = "/voting.v1.UserService/AnswerProposal"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UserService holds the citizen-facing RPCs. All of them act on behalf of the user from the access token.
+type UserServiceClient interface {
+	CreatePetition(ctx context.Context, in *CreatePetitionRequest, opts ...grpc.CallOption) (*Petition, error)
+	GetPetition(ctx context.Context, in *GetPetitionRequest, opts ...grpc.CallOption) (*Petition, error)
+	ListPetitions(ctx context.Context, in *ListPetitionsRequest, opts ...grpc.CallOption) (*ListPetitionsResponse, error)
+	SignPetition(ctx context.Context, in *SignPetitionRequest, opts ...grpc.CallOption) (*Petition, error)
+	UnsignPetition(ctx context.Context, in *UnsignPetitionRequest, opts ...grpc.CallOption) (*Petition, error)
+	// Returns NOT_FOUND when the user has not signed the petition.
+	GetMySignature(ctx context.Context, in *GetMySignatureRequest, opts ...grpc.CallOption) (*PetitionSignature, error)
+	// Withdraws a petition on behalf of its initiator.
+	WithdrawMyPetition(ctx context.Context, in *WithdrawMyPetitionRequest, opts ...grpc.CallOption) (*Petition, error)
+	// Answers a petition awaiting response; only its addressee may do this.
+	AnswerPetition(ctx context.Context, in *AnswerPetitionRequest, opts ...grpc.CallOption) (*Petition, error)
+	CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*Poll, error)
+	GetPoll(ctx context.Context, in *GetPollRequest, opts ...grpc.CallOption) (*Poll, error)
+	ListPolls(ctx context.Context, in *ListPollsRequest, opts ...grpc.CallOption) (*ListPollsResponse, error)
+	// Casts the user's ballot or replaces the one cast before; secret ballots cannot be changed.
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	RetractPollVote(ctx context.Context, in *RetractPollVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMyPollVote(ctx context.Context, in *GetMyPollVoteRequest, opts ...grpc.CallOption) (*GetMyPollVoteResponse, error)
+	GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*PollResults, error)
+	// Streams the vote counters of the poll: the current ones first, then one snapshot per coalescing interval
+	// in which they changed. Only published and closed polls can be watched, others are NOT_FOUND;
+	// the number of concurrent streams per caller is limited by rate_limit.max_streams (RESOURCE_EXHAUSTED).
+	WatchPollTally(ctx context.Context, in *WatchPollTallyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PollTally], error)
+	// Withdraws a poll on behalf of its initiator.
+	WithdrawMyPoll(ctx context.Context, in *WithdrawMyPollRequest, opts ...grpc.CallOption) (*Poll, error)
+	// The option RPCs are available to the initiator while the poll is processed or has no votes;
+	// afterwards they fail with FAILED_PRECONDITION (reason OPTIONS_LOCKED).
+	// A max_choices equal to the number of options follows it as options are added or removed;
+	// a smaller one stays fixed.
+	// Appends an option to the end of the poll.
+	AddPollOption(ctx context.Context, in *AddPollOptionRequest, opts ...grpc.CallOption) (*Poll, error)
+	EditPollOption(ctx context.Context, in *EditPollOptionRequest, opts ...grpc.CallOption) (*Poll, error)
+	RemovePollOption(ctx context.Context, in *RemovePollOptionRequest, opts ...grpc.CallOption) (*Poll, error)
+	ReorderPollOptions(ctx context.Context, in *ReorderPollOptionsRequest, opts ...grpc.CallOption) (*Poll, error)
+	CreateProposal(ctx context.Context, in *CreateProposalRequest, opts ...grpc.CallOption) (*Proposal, error)
+	GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*Proposal, error)
+	ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error)
+	// Casts the user's vote or changes the one cast before.
+	VoteProposal(ctx context.Context, in *VoteProposalRequest, opts ...grpc.CallOption) (*ProposalVote, error)
+	RetractProposalVote(ctx context.Context, in *RetractProposalVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns NOT_FOUND when the user has not voted on the proposal.
+	GetMyProposalVote(ctx context.Context, in *GetMyProposalVoteRequest, opts ...grpc.CallOption) (*ProposalVote, error)
+	// Streams the vote counters of a published, decided or closed proposal, see WatchPollTally.
+	WatchProposalTally(ctx context.Context, in *WatchProposalTallyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProposalTally], error)
+	// Withdraws a proposal on behalf of its initiator.
+	WithdrawMyProposal(ctx context.Context, in *WithdrawMyProposalRequest, opts ...grpc.CallOption) (*Proposal, error)
+	// Issues the final verdict on a proposal; only its addressee may do this.
+	AnswerProposal(ctx context.Context, in *AnswerProposalRequest, opts ...grpc.CallOption) (*Proposal, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreatePetition(ctx context.Context, in *CreatePetitionRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, UserService_CreatePetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPetition(ctx context.Context, in *GetPetitionRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, UserService_GetPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListPetitions(ctx context.Context, in *ListPetitionsRequest, opts ...grpc.CallOption) (*ListPetitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPetitionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListPetitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SignPetition(ctx context.Context, in *SignPetitionRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, UserService_SignPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnsignPetition(ctx context.Context, in *UnsignPetitionRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, UserService_UnsignPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMySignature(ctx context.Context, in *GetMySignatureRequest, opts ...grpc.CallOption) (*PetitionSignature, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PetitionSignature)
+	err := c.cc.Invoke(ctx, UserService_GetMySignature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) WithdrawMyPetition(ctx context.Context, in *WithdrawMyPetitionRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, UserService_WithdrawMyPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AnswerPetition(ctx context.Context, in *AnswerPetitionRequest, opts ...grpc.CallOption) (*Petition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Petition)
+	err := c.cc.Invoke(ctx, UserService_AnswerPetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, UserService_CreatePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPoll(ctx context.Context, in *GetPollRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, UserService_GetPoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListPolls(ctx context.Context, in *ListPollsRequest, opts ...grpc.CallOption) (*ListPollsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPollsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListPolls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, UserService_VotePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RetractPollVote(ctx context.Context, in *RetractPollVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RetractPollVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMyPollVote(ctx context.Context, in *GetMyPollVoteRequest, opts ...grpc.CallOption) (*GetMyPollVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyPollVoteResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMyPollVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*PollResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PollResults)
+	err := c.cc.Invoke(ctx, UserService_GetPollResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) WatchPollTally(ctx context.Context, in *WatchPollTallyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PollTally], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_WatchPollTally_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPollTallyRequest, PollTally]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_WatchPollTallyClient = grpc.ServerStreamingClient[PollTally]
+
+func (c *userServiceClient) WithdrawMyPoll(ctx context.Context, in *WithdrawMyPollRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, UserService_WithdrawMyPoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddPollOption(ctx context.Context, in *AddPollOptionRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, UserService_AddPollOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) EditPollOption(ctx context.Context, in *EditPollOptionRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, UserService_EditPollOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemovePollOption(ctx context.Context, in *RemovePollOptionRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, UserService_RemovePollOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReorderPollOptions(ctx context.Context, in *ReorderPollOptionsRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, UserService_ReorderPollOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateProposal(ctx context.Context, in *CreateProposalRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, UserService_CreateProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, UserService_GetProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProposalsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VoteProposal(ctx context.Context, in *VoteProposalRequest, opts ...grpc.CallOption) (*ProposalVote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposalVote)
+	err := c.cc.Invoke(ctx, UserService_VoteProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RetractProposalVote(ctx context.Context, in *RetractProposalVoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RetractProposalVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMyProposalVote(ctx context.Context, in *GetMyProposalVoteRequest, opts ...grpc.CallOption) (*ProposalVote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposalVote)
+	err := c.cc.Invoke(ctx, UserService_GetMyProposalVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) WatchProposalTally(ctx context.Context, in *WatchProposalTallyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProposalTally], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[1], UserService_WatchProposalTally_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchProposalTallyRequest, ProposalTally]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_WatchProposalTallyClient = grpc.ServerStreamingClient[ProposalTally]
+
+func (c *userServiceClient) WithdrawMyProposal(ctx context.Context, in *WithdrawMyProposalRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, UserService_WithdrawMyProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AnswerProposal(ctx context.Context, in *AnswerProposalRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, UserService_AnswerProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+//
+// UserService holds the citizen-facing RPCs. All of them act on behalf of the user from the access token.
+type UserServiceServer interface {
+	CreatePetition(context.Context, *CreatePetitionRequest) (*Petition, error)
+	GetPetition(context.Context, *GetPetitionRequest) (*Petition, error)
+	ListPetitions(context.Context, *ListPetitionsRequest) (*ListPetitionsResponse, error)
+	SignPetition(context.Context, *SignPetitionRequest) (*Petition, error)
+	UnsignPetition(context.Context, *UnsignPetitionRequest) (*Petition, error)
+	// Returns NOT_FOUND when the user has not signed the petition.
+	GetMySignature(context.Context, *GetMySignatureRequest) (*PetitionSignature, error)
+	// Withdraws a petition on behalf of its initiator.
+	WithdrawMyPetition(context.Context, *WithdrawMyPetitionRequest) (*Petition, error)
+	// Answers a petition awaiting response; only its addressee may do this.
+	AnswerPetition(context.Context, *AnswerPetitionRequest) (*Petition, error)
+	CreatePoll(context.Context, *CreatePollRequest) (*Poll, error)
+	GetPoll(context.Context, *GetPollRequest) (*Poll, error)
+	ListPolls(context.Context, *ListPollsRequest) (*ListPollsResponse, error)
+	// Casts the user's ballot or replaces the one cast before; secret ballots cannot be changed.
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	RetractPollVote(context.Context, *RetractPollVoteRequest) (*emptypb.Empty, error)
+	GetMyPollVote(context.Context, *GetMyPollVoteRequest) (*GetMyPollVoteResponse, error)
+	GetPollResults(context.Context, *GetPollResultsRequest) (*PollResults, error)
+	// Streams the vote counters of the poll: the current ones first, then one snapshot per coalescing interval
+	// in which they changed. Only published and closed polls can be watched, others are NOT_FOUND;
+	// the number of concurrent streams per caller is limited by rate_limit.max_streams (RESOURCE_EXHAUSTED).
+	WatchPollTally(*WatchPollTallyRequest, grpc.ServerStreamingServer[PollTally]) error
+	// Withdraws a poll on behalf of its initiator.
+	WithdrawMyPoll(context.Context, *WithdrawMyPollRequest) (*Poll, error)
+	// The option RPCs are available to the initiator while the poll is processed or has no votes;
+	// afterwards they fail with FAILED_PRECONDITION (reason OPTIONS_LOCKED).
+	// A max_choices equal to the number of options follows it as options are added or removed;
+	// a smaller one stays fixed.
+	// Appends an option to the end of the poll.
+	AddPollOption(context.Context, *AddPollOptionRequest) (*Poll, error)
+	EditPollOption(context.Context, *EditPollOptionRequest) (*Poll, error)
+	RemovePollOption(context.Context, *RemovePollOptionRequest) (*Poll, error)
+	ReorderPollOptions(context.Context, *ReorderPollOptionsRequest) (*Poll, error)
+	CreateProposal(context.Context, *CreateProposalRequest) (*Proposal, error)
+	GetProposal(context.Context, *GetProposalRequest) (*Proposal, error)
+	ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error)
+	// Casts the user's vote or changes the one cast before.
+	VoteProposal(context.Context, *VoteProposalRequest) (*ProposalVote, error)
+	RetractProposalVote(context.Context, *RetractProposalVoteRequest) (*emptypb.Empty, error)
+	// Returns NOT_FOUND when the user has not voted on the proposal.
+	GetMyProposalVote(context.Context, *GetMyProposalVoteRequest) (*ProposalVote, error)
+	// Streams the vote counters of a published, decided or closed proposal, see WatchPollTally.
+	WatchProposalTally(*WatchProposalTallyRequest, grpc.ServerStreamingServer[ProposalTally]) error
+	// Withdraws a proposal on behalf of its initiator.
+	WithdrawMyProposal(context.Context, *WithdrawMyProposalRequest) (*Proposal, error)
+	// Issues the final verdict on a proposal; only its addressee may do this.
+	AnswerProposal(context.Context, *AnswerProposalRequest) (*Proposal, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) CreatePetition(context.Context, *CreatePetitionRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePetition not implemented")
+}
+func (UnimplementedUserServiceServer) GetPetition(context.Context, *GetPetitionRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPetition not implemented")
+}
+func (UnimplementedUserServiceServer) ListPetitions(context.Context, *ListPetitionsRequest) (*ListPetitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPetitions not implemented")
+}
+func (UnimplementedUserServiceServer) SignPetition(context.Context, *SignPetitionRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPetition not implemented")
+}
+func (UnimplementedUserServiceServer) UnsignPetition(context.Context, *UnsignPetitionRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignPetition not implemented")
+}
+func (UnimplementedUserServiceServer) GetMySignature(context.Context, *GetMySignatureRequest) (*PetitionSignature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMySignature not implemented")
+}
+func (UnimplementedUserServiceServer) WithdrawMyPetition(context.Context, *WithdrawMyPetitionRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMyPetition not implemented")
+}
+func (UnimplementedUserServiceServer) AnswerPetition(context.Context, *AnswerPetitionRequest) (*Petition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerPetition not implemented")
+}
+func (UnimplementedUserServiceServer) CreatePoll(context.Context, *CreatePollRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePoll not implemented")
+}
+func (UnimplementedUserServiceServer) GetPoll(context.Context, *GetPollRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoll not implemented")
+}
+func (UnimplementedUserServiceServer) ListPolls(context.Context, *ListPollsRequest) (*ListPollsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolls not implemented")
+}
+func (UnimplementedUserServiceServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedUserServiceServer) RetractPollVote(context.Context, *RetractPollVoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractPollVote not implemented")
+}
+func (UnimplementedUserServiceServer) GetMyPollVote(context.Context, *GetMyPollVoteRequest) (*GetMyPollVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyPollVote not implemented")
+}
+func (UnimplementedUserServiceServer) GetPollResults(context.Context, *GetPollResultsRequest) (*PollResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollResults not implemented")
+}
+func (UnimplementedUserServiceServer) WatchPollTally(*WatchPollTallyRequest, grpc.ServerStreamingServer[PollTally]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPollTally not implemented")
+}
+func (UnimplementedUserServiceServer) WithdrawMyPoll(context.Context, *WithdrawMyPollRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMyPoll not implemented")
+}
+func (UnimplementedUserServiceServer) AddPollOption(context.Context, *AddPollOptionRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPollOption not implemented")
+}
+func (UnimplementedUserServiceServer) EditPollOption(context.Context, *EditPollOptionRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPollOption not implemented")
+}
+func (UnimplementedUserServiceServer) RemovePollOption(context.Context, *RemovePollOptionRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePollOption not implemented")
+}
+func (UnimplementedUserServiceServer) ReorderPollOptions(context.Context, *ReorderPollOptionsRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPollOptions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateProposal(context.Context, *CreateProposalRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProposal not implemented")
+}
+func (UnimplementedUserServiceServer) GetProposal(context.Context, *GetProposalRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposal not implemented")
+}
+func (UnimplementedUserServiceServer) ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposals not implemented")
+}
+func (UnimplementedUserServiceServer) VoteProposal(context.Context, *VoteProposalRequest) (*ProposalVote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteProposal not implemented")
+}
+func (UnimplementedUserServiceServer) RetractProposalVote(context.Context, *RetractProposalVoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractProposalVote not implemented")
+}
+func (UnimplementedUserServiceServer) GetMyProposalVote(context.Context, *GetMyProposalVoteRequest) (*ProposalVote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyProposalVote not implemented")
+}
+func (UnimplementedUserServiceServer) WatchProposalTally(*WatchProposalTallyRequest, grpc.ServerStreamingServer[ProposalTally]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProposalTally not implemented")
+}
+func (UnimplementedUserServiceServer) WithdrawMyProposal(context.Context, *WithdrawMyProposalRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMyProposal not implemented")
+}
+func (UnimplementedUserServiceServer) AnswerProposal(context.Context, *AnswerProposalRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerProposal not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_CreatePetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreatePetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreatePetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreatePetition(ctx, req.(*CreatePetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPetition(ctx, req.(*GetPetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListPetitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPetitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListPetitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListPetitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListPetitions(ctx, req.(*ListPetitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SignPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SignPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SignPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SignPetition(ctx, req.(*SignPetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnsignPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignPetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnsignPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnsignPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnsignPetition(ctx, req.(*UnsignPetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMySignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMySignature(ctx, req.(*GetMySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_WithdrawMyPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawMyPetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).WithdrawMyPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_WithdrawMyPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).WithdrawMyPetition(ctx, req.(*WithdrawMyPetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AnswerPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerPetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AnswerPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AnswerPetition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AnswerPetition(ctx, req.(*AnswerPetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreatePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreatePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreatePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreatePoll(ctx, req.(*CreatePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPoll(ctx, req.(*GetPollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListPolls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPollsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListPolls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListPolls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListPolls(ctx, req.(*ListPollsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RetractPollVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractPollVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RetractPollVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RetractPollVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RetractPollVote(ctx, req.(*RetractPollVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMyPollVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyPollVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMyPollVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMyPollVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMyPollVote(ctx, req.(*GetMyPollVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPollResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPollResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPollResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPollResults(ctx, req.(*GetPollResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_WatchPollTally_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPollTallyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).WatchPollTally(m, &grpc.GenericServerStream[WatchPollTallyRequest, PollTally]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_WatchPollTallyServer = grpc.ServerStreamingServer[PollTally]
+
+func _UserService_WithdrawMyPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawMyPollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).WithdrawMyPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_WithdrawMyPoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).WithdrawMyPoll(ctx, req.(*WithdrawMyPollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddPollOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPollOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddPollOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddPollOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddPollOption(ctx, req.(*AddPollOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EditPollOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPollOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EditPollOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EditPollOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EditPollOption(ctx, req.(*EditPollOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemovePollOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePollOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemovePollOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemovePollOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemovePollOption(ctx, req.(*RemovePollOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReorderPollOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPollOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReorderPollOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReorderPollOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReorderPollOptions(ctx, req.(*ReorderPollOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateProposal(ctx, req.(*CreateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProposal(ctx, req.(*GetProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListProposals(ctx, req.(*ListProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VoteProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VoteProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VoteProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VoteProposal(ctx, req.(*VoteProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RetractProposalVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractProposalVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RetractProposalVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RetractProposalVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RetractProposalVote(ctx, req.(*RetractProposalVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMyProposalVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyProposalVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMyProposalVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMyProposalVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMyProposalVote(ctx, req.(*GetMyProposalVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_WatchProposalTally_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProposalTallyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).WatchProposalTally(m, &grpc.GenericServerStream[WatchProposalTallyRequest, ProposalTally]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_WatchProposalTallyServer = grpc.ServerStreamingServer[ProposalTally]
+
+func _UserService_WithdrawMyProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawMyProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).WithdrawMyProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_WithdrawMyProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).WithdrawMyProposal(ctx, req.(*WithdrawMyProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AnswerProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AnswerProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AnswerProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AnswerProposal(ctx, req.(*AnswerProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "voting.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePetition",
+			Handler:    _UserService_CreatePetition_Handler,
+		},
+		{
+			MethodName: "GetPetition",
+			Handler:    _UserService_GetPetition_Handler,
+		},
+		{
+			MethodName: "ListPetitions",
+			Handler:    _UserService_ListPetitions_Handler,
+		},
+		{
+			MethodName: "SignPetition",
+			Handler:    _UserService_SignPetition_Handler,
+		},
+		{
+			MethodName: "UnsignPetition",
+			Handler:    _UserService_UnsignPetition_Handler,
+		},
+		{
+			MethodName: "GetMySignature",
+			Handler:    _UserService_GetMySignature_Handler,
+		},
+		{
+			MethodName: "WithdrawMyPetition",
+			Handler:    _UserService_WithdrawMyPetition_Handler,
+		},
+		{
+			MethodName: "AnswerPetition",
+			Handler:    _UserService_AnswerPetition_Handler,
+		},
+		{
+			MethodName: "CreatePoll",
+			Handler:    _UserService_CreatePoll_Handler,
+		},
+		{
+			MethodName: "GetPoll",
+			Handler:    _UserService_GetPoll_Handler,
+		},
+		{
+			MethodName: "ListPolls",
+			Handler:    _UserService_ListPolls_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _UserService_VotePoll_Handler,
+		},
+		{
+			MethodName: "RetractPollVote",
+			Handler:    _UserService_RetractPollVote_Handler,
+		},
+		{
+			MethodName: "GetMyPollVote",
+			Handler:    _UserService_GetMyPollVote_Handler,
+		},
+		{
+			MethodName: "GetPollResults",
+			Handler:    _UserService_GetPollResults_Handler,
+		},
+		{
+			MethodName: "WithdrawMyPoll",
+			Handler:    _UserService_WithdrawMyPoll_Handler,
+		},
+		{
+			MethodName: "AddPollOption",
+			Handler:    _UserService_AddPollOption_Handler,
+		},
+		{
+			MethodName: "EditPollOption",
+			Handler:    _UserService_EditPollOption_Handler,
+		},
+		{
+			MethodName: "RemovePollOption",
+			Handler:    _UserService_RemovePollOption_Handler,
+		},
+		{
+			MethodName: "ReorderPollOptions",
+			Handler:    _UserService_ReorderPollOptions_Handler,
+		},
+		{
+			MethodName: "CreateProposal",
+			Handler:    _UserService_CreateProposal_Handler,
+		},
+		{
+			MethodName: "GetProposal",
+			Handler:    _UserService_GetProposal_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _UserService_ListProposals_Handler,
+		},
+		{
+			MethodName: "VoteProposal",
+			Handler:    _UserService_VoteProposal_Handler,
+		},
+		{
+			MethodName: "RetractProposalVote",
+			Handler:    _UserService_RetractProposalVote_Handler,
+		},
+		{
+			MethodName: "GetMyProposalVote",
+			Handler:    _UserService_GetMyProposalVote_Handler,
+		},
+		{
+			MethodName: "WithdrawMyProposal",
+			Handler:    _UserService_WithdrawMyProposal_Handler,
+		},
+		{
+			MethodName: "AnswerProposal",
+			Handler:    _UserService_AnswerProposal_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPollTally",
+			Handler:       _UserService_WatchPollTally_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchProposalTally",
+			Handler:       _UserService_WatchProposalTally_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "voting/v1/user.proto",
+}